@@ -0,0 +1,195 @@
+package sodogo
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
+/*
+     Batch output example, tab separated, a line per puzzle in input order
+
+  solution                                                                           status    steps  elapsed
+  864371259325849761971265843436192587198657432257483916689734125713528694542916378  solved    7      1.2ms
+  004300209005009001070060043006002087190007400050083000600000105003508690042910300  unsolved  3      0.8ms
+  00430020                                                                           invalid   0      0s     A valid board text contains 81 cells, not 8
+
+*/
+
+// Batch result status
+const (
+	BatchSolved   = "solved"   // puzzle solved
+	BatchUnsolved = "unsolved" // the solver got stuck, the partial board is written
+	BatchInvalid  = "invalid"  // malformed line or conflicting givens
+)
+
+// maxBatchLine the longest puzzle line, longer lines are invalid
+const maxBatchLine = 64 << 10
+
+// BatchResult result of a batch puzzle
+type BatchResult struct {
+	Line     int           // input line number
+	Solution string        // solved board, the partial board, the input line when invalid or empty when too long
+	Status   string        // BatchSolved, BatchUnsolved or BatchInvalid
+	Steps    int           // solver steps
+	Elapsed  time.Duration // solver elapsed time
+	Err      error         // load error of an invalid puzzle
+}
+
+// BatchStats number of puzzles of every status
+type BatchStats struct {
+	Solved   int
+	Unsolved int
+	Invalid  int
+}
+
+// batchJob a puzzle line to solve
+type batchJob struct {
+	num     int // job order
+	line    int // input line number
+	text    string
+	tooLong bool // the line is over maxBatchLine, text is empty
+}
+
+// batchDone a solved job
+type batchDone struct {
+	num int // job order
+	res BatchResult
+}
+
+// SolveBatch reads a puzzle per line from r, solves them with workers
+// goroutines (the number of CPUs when lower than 1) and writes a result per
+// line to w, in input order. Empty lines are skipped, malformed lines and
+// lines over 64KiB are written as invalid. Only read and write errors stop
+// the batch.
+func SolveBatch(r io.Reader, w io.Writer, h HelperBoard, workers int) (stats BatchStats, err error) {
+	if workers < 1 {
+		workers = runtime.NumCPU()
+	}
+	jobs := make(chan batchJob)
+	results := make(chan batchDone)
+	pending := make(chan struct{}, workers*4) // limits the results waiting for an earlier one
+	done := make(chan struct{})
+
+	var wg sync.WaitGroup
+	for inc := 0; inc < workers; inc++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range jobs {
+				if job.tooLong {
+					err := fmt.Errorf("Line longer than %d bytes", maxBatchLine)
+					results <- batchDone{job.num, BatchResult{Line: job.line, Status: BatchInvalid, Err: err}}
+					continue
+				}
+				results <- batchDone{job.num, SolveLine(job.text, h, job.line)}
+			}
+		}()
+	}
+
+	var readErr error
+	go func() {
+		defer close(jobs)
+		reader := bufio.NewReaderSize(r, maxBatchLine)
+		num := 0
+		for line := 1; ; line++ {
+			text, tooLong, err := readBatchLine(reader)
+			if err != nil && err != io.EOF {
+				readErr = err
+				return
+			}
+			if text = strings.TrimSpace(text); text != "" || tooLong {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				select {
+				case pending <- struct{}{}:
+				case <-done:
+					return
+				}
+				jobs <- batchJob{num: num, line: line, text: text, tooLong: tooLong}
+				num++
+			}
+			if err == io.EOF {
+				return
+			}
+		}
+	}()
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	waiting := map[int]BatchResult{}
+	next := 0
+	for result := range results {
+		waiting[result.num] = result.res
+		for res, ok := waiting[next]; ok; res, ok = waiting[next] {
+			delete(waiting, next)
+			next++
+			<-pending
+			if err != nil {
+				continue
+			}
+			switch res.Status {
+			case BatchSolved:
+				stats.Solved++
+			case BatchUnsolved:
+				stats.Unsolved++
+			default:
+				stats.Invalid++
+			}
+			if _, err = io.WriteString(w, res.String()+"\n"); err != nil {
+				close(done)
+			}
+		}
+	}
+	if err != nil {
+		return stats, err
+	}
+	return stats, readErr
+}
+
+// readBatchLine returns the next line, tooLong without the line when it does
+// not fit the reader buffer, the rest of the line is skipped
+func readBatchLine(reader *bufio.Reader) (text string, tooLong bool, err error) {
+	chunk, err := reader.ReadSlice('\n')
+	if err != bufio.ErrBufferFull {
+		return string(chunk), false, err
+	}
+	for err == bufio.ErrBufferFull {
+		_, err = reader.ReadSlice('\n')
+	}
+	return "", true, err
+}
+
+// SolveLine solves a puzzle line, line is the input line number of the result
+func SolveLine(text string, h HelperBoard, line int) (res BatchResult) {
+	res = BatchResult{Line: line, Solution: text, Status: BatchInvalid}
+	b := NewBoard(h)
+	if res.Err = b.LoadFromText(text); res.Err != nil {
+		return res
+	}
+	res.Status = BatchUnsolved
+	if b.Solve() {
+		res.Status = BatchSolved
+	}
+	res.Solution, res.Steps, res.Elapsed = b.String(), b.Steps, b.Elapsed
+	return res
+}
+
+// String returns the result as tab separated solution, status, steps,
+// elapsed and the error of an invalid puzzle
+func (r BatchResult) String() string {
+	res := fmt.Sprintf("%s\t%s\t%d\t%s", r.Solution, r.Status, r.Steps, r.Elapsed)
+	if r.Err != nil {
+		res += "\t" + r.Err.Error()
+	}
+	return res
+}
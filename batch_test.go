@@ -0,0 +1,169 @@
+package sodogo
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// failingWriter fails every write
+type failingWriter struct{}
+
+func (failingWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("write failed")
+}
+
+func TestSolveBatch(t *testing.T) {
+	input := strings.Join([]string{
+		"004300209005009001070060043006002087190007400050083000600000105003508690042910300",
+		"",
+		"800000000003600000070090200050007000000045700000100030001000068008500010090000400",
+		"00430020",
+		"..4.......................................................................44.....",
+		"..43..2.9..5..9..1.7..6..43..6..2.8719...74...5..83...6.....1.5..35.869..4291.3..",
+	}, "\n")
+	tests := []struct {
+		name      string
+		workers   int
+		want      []string
+		wantLines []string
+		wantStats BatchStats
+	}{
+		{
+			name:      "1 worker",
+			workers:   1,
+			want:      []string{BatchSolved, BatchUnsolved, BatchInvalid, BatchInvalid, BatchSolved},
+			wantLines: []string{"864371259325849761971265843436192587198657432257483916689734125713528694542916378", "", "00430020", "", ""},
+			wantStats: BatchStats{Solved: 2, Unsolved: 1, Invalid: 2},
+		},
+		{
+			name:      "4 workers",
+			workers:   4,
+			want:      []string{BatchSolved, BatchUnsolved, BatchInvalid, BatchInvalid, BatchSolved},
+			wantLines: []string{"864371259325849761971265843436192587198657432257483916689734125713528694542916378", "", "00430020", "", ""},
+			wantStats: BatchStats{Solved: 2, Unsolved: 1, Invalid: 2},
+		},
+		{
+			name:      "default workers",
+			workers:   0,
+			want:      []string{BatchSolved, BatchUnsolved, BatchInvalid, BatchInvalid, BatchSolved},
+			wantLines: []string{"864371259325849761971265843436192587198657432257483916689734125713528694542916378", "", "00430020", "", ""},
+			wantStats: BatchStats{Solved: 2, Unsolved: 1, Invalid: 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buffer bytes.Buffer
+			stats, err := SolveBatch(strings.NewReader(input), &buffer, NewHelperBoard(3), tt.workers)
+			if err != nil {
+				t.Fatalf("SolveBatch() error = %v", err)
+			}
+			if stats != tt.wantStats {
+				t.Errorf("SolveBatch() stats = %v, want %v", stats, tt.wantStats)
+			}
+			lines := strings.Split(strings.TrimSuffix(buffer.String(), "\n"), "\n")
+			res := []string{}
+			for num, line := range lines {
+				fields := strings.Split(line, "\t")
+				res = append(res, fields[1])
+				if tt.wantLines[num] != "" && fields[0] != tt.wantLines[num] {
+					t.Errorf("SolveBatch() line %d solution = %v, want %v", num, fields[0], tt.wantLines[num])
+				}
+			}
+			if !reflect.DeepEqual(res, tt.want) {
+				t.Errorf("SolveBatch() status = %v, want %v", res, tt.want)
+			}
+		})
+	}
+}
+
+func TestSolveBatch_manyPuzzles(t *testing.T) {
+	lines := []string{}
+	for num := 0; num < 200; num++ {
+		if num%3 == 0 {
+			lines = append(lines, "bad line")
+			continue
+		}
+		lines = append(lines, "004300209005009001070060043006002087190007400050083000600000105003508690042910300")
+	}
+	var buffer bytes.Buffer
+	stats, err := SolveBatch(strings.NewReader(strings.Join(lines, "\n")), &buffer, NewHelperBoard(3), 3)
+	if err != nil {
+		t.Fatalf("SolveBatch() error = %v", err)
+	}
+	if want := (BatchStats{Solved: 133, Invalid: 67}); stats != want {
+		t.Errorf("SolveBatch() stats = %v, want %v", stats, want)
+	}
+	for num, line := range strings.Split(strings.TrimSuffix(buffer.String(), "\n"), "\n") {
+		if invalid := strings.Contains(line, BatchInvalid); invalid != (num%3 == 0) {
+			t.Errorf("SolveBatch() line %d out of order: %v", num, line)
+		}
+	}
+}
+
+func TestSolveBatch_longLine(t *testing.T) {
+	puzzle := "004300209005009001070060043006002087190007400050083000600000105003508690042910300"
+	input := strings.Join([]string{puzzle, strings.Repeat("0", maxBatchLine*2), puzzle}, "\n")
+	var buffer bytes.Buffer
+	stats, err := SolveBatch(strings.NewReader(input), &buffer, NewHelperBoard(3), 2)
+	if err != nil {
+		t.Fatalf("SolveBatch() error = %v", err)
+	}
+	if want := (BatchStats{Solved: 2, Invalid: 1}); stats != want {
+		t.Errorf("SolveBatch() stats = %v, want %v", stats, want)
+	}
+	lines := strings.Split(strings.TrimSuffix(buffer.String(), "\n"), "\n")
+	if want := "\tinvalid\t0\t0s\tLine longer than 65536 bytes"; len(lines) != 3 || lines[1] != want {
+		t.Errorf("SolveBatch() lines = %q, want %q", lines, want)
+	}
+}
+
+func TestSolveBatch_writeError(t *testing.T) {
+	input := strings.Repeat("004300209005009001070060043006002087190007400050083000600000105003508690042910300\n", 50)
+	if _, err := SolveBatch(strings.NewReader(input), failingWriter{}, NewHelperBoard(3), 2); err == nil {
+		t.Errorf("SolveBatch() error = %v, want write error", err)
+	}
+}
+
+func TestSolveLine(t *testing.T) {
+	tests := []struct {
+		name       string
+		text       string
+		wantStatus string
+		wantErr    bool
+	}{
+		{
+			name:       "2x2 solved",
+			text:       "1.3.3..2.1.34.2.",
+			wantStatus: BatchSolved,
+		},
+		{
+			name:       "2x2 malformed",
+			text:       "1.3..4",
+			wantStatus: BatchInvalid,
+			wantErr:    true,
+		},
+		{
+			name:       "2x2 conflicting givens",
+			text:       "11..............",
+			wantStatus: BatchInvalid,
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := SolveLine(tt.text, NewHelperBoard(2), 1)
+			if (res.Err != nil) != tt.wantErr {
+				t.Fatalf("SolveLine() error = %v, wantErr %v", res.Err, tt.wantErr)
+			}
+			if res.Status != tt.wantStatus {
+				t.Errorf("SolveLine() status = %v, want %v", res.Status, tt.wantStatus)
+			}
+			if tt.wantErr && !strings.HasSuffix(res.String(), res.Err.Error()) {
+				t.Errorf("SolveLine() String() = %v, want the error", res.String())
+			}
+		})
+	}
+}
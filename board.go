@@ -3,7 +3,6 @@ package sodogo
 import (
 	"bytes"
 	"fmt"
-	"strconv"
 	"time"
 )
 
@@ -24,16 +23,30 @@ import (
 
 */
 
-//Board sudoku board data
+//Board sudoku board data, always used through a *Board: go vet reports
+// copies of a Board value, Clone returns an independent copy
 type Board struct {
-	data    []cell        // cell value and potential values
-	helpers HelperBoard   // helpers to calculate neighbors
-	Steps   int           // 0 steps
-	Elapsed time.Duration // 0 elapsed time
+	noCopy      noCopy          // makes go vet report Board copies
+	data        []cell          // cell value and potential values
+	helpers     HelperBoard     // helpers to calculate neighbors
+	constraints []constraint    // extra rules, like outside clues
+	tracer      func(TraceStep) // called for every filled cell while solving
+	Steps       int             // 0 steps
+	Elapsed     time.Duration   // 0 elapsed time
 }
 type cell struct {
 	value     int       // cell value
 	potential potential // potential cell values
+	parity    Parity    // odd or even restriction
+	given     bool      // value loaded with the puzzle
+}
+
+// constraint an extra rule of the board
+type constraint interface {
+	prune(b *Board) int            // removes potential values, returns the number of changes
+	isValid(b *Board) bool         // returns if the filled cells follow the rule
+	conflicts(b *Board) []int      // returns the filled cells breaking the rule
+	breaks(b *Board, pos int) bool // returns if a filled cell breaks the rule
 }
 
 type neighbors []int // neighbors values
@@ -51,6 +64,10 @@ type streetXNeighborsPotential struct {
 	value int
 }
 
+type extraNeighborsPotential struct {
+	group []int
+}
+
 type neighborsPotential interface {
 	getNeighborsPotentialValues(h HelperBoard) (int, []int)
 }
@@ -68,9 +85,22 @@ func (p streetXNeighborsPotential) getNeighborsPotentialValues(h HelperBoard) (i
 	return p.value % h.maxValue, h.streetXNeighbors
 }
 
+func (p extraNeighborsPotential) getNeighborsPotentialValues(h HelperBoard) (int, []int) {
+	return 0, p.group
+}
+
+// noCopy a guard for the go vet copylocks check
+type noCopy struct{}
+
+// Lock is a no-op used by the go vet copylocks check
+func (*noCopy) Lock() {}
+
+// Unlock is a no-op used by the go vet copylocks check
+func (*noCopy) Unlock() {}
+
 // NewBoard create a new board
-func NewBoard(h HelperBoard) (b Board) {
-	b = Board{
+func NewBoard(h HelperBoard) (b *Board) {
+	b = &Board{
 		data:    make([]cell, h.boardSize),
 		helpers: h,
 		Steps:   0,
@@ -81,19 +111,22 @@ func NewBoard(h HelperBoard) (b Board) {
 }
 
 // LoadFromString converts a string to a board
-func (b Board) LoadFromString(board string) error {
+func (b *Board) LoadFromString(board string) error {
 	if len(board) != b.helpers.boardSize {
 		return fmt.Errorf("A valid board definition contains %d caracters, not %d", b.helpers.boardSize, len(board))
 	}
 
 	for inc := 0; inc < len(board); inc++ {
-		value, err := strconv.Atoi(string(board[inc]))
-		if err != nil {
-			value = 0
-		}
+		value := b.helpers.getSymbolValue(board[inc])
+		parity := b.data[inc].parity
 		b.data[inc] = cell{
 			value:     value,
 			potential: []int{value},
+			parity:    parity,
+			given:     value != 0,
+		}
+		if value == 0 && parity != AnyParity {
+			b.setPotential(inc, b.helpers.getParityValues(parity))
 		}
 	}
 	return nil
@@ -102,50 +135,62 @@ func (b Board) LoadFromString(board string) error {
 // Solve the Sudoku
 func (b *Board) Solve() bool {
 	start := time.Now()
-	var np []neighborsPotential
 	step := 1
 	for !b.isSolved() {
-		stepChanges := 0
-		for pos := 0; pos < b.helpers.boardSize; pos++ {
-
-			if value := b.getValue(pos); value == 0 {
-				allNeighborsValues := b.getAllNeighborsValues(pos)
-				value, potentialValues := allNeighborsValues.getPotentialValues(b.helpers.validValues)
-				if len(b.getPotential(pos)) != len(potentialValues) {
-					b.setPotential(pos, potentialValues)
-					stepChanges++
-				}
+		b.Steps = step
+		if b.solveStep() == 0 {
+			break
+		}
+		step++
+	}
+	b.Steps = step
+	b.Elapsed = time.Since(start)
+	return b.isSolved()
+}
+
+// solveStep runs a pass over every cell, returns the number of changes
+func (b *Board) solveStep() (stepChanges int) {
+	var np []neighborsPotential
+	for _, c := range b.constraints {
+		stepChanges += c.prune(b)
+	}
+	for pos := 0; pos < b.helpers.boardSize; pos++ {
+
+		if value := b.getValue(pos); value == 0 {
+			potentialValues := b.getCandidates(pos)
+			if len(b.getPotential(pos)) != len(potentialValues) {
+				b.setPotential(pos, potentialValues)
+				stepChanges++
+			}
+			if len(potentialValues) == 1 {
+				b.setValue(pos, potentialValues[0])
+				b.trace(pos, TechniqueNakedSingle, "")
+				stepChanges++
+				continue
+			}
+			flat := flatNeighborsPotential{pos}
+			streetY := streetYNeighborsPotential{pos}
+			streetX := streetXNeighborsPotential{pos}
+			np = []neighborsPotential{flat, streetY, streetX}
+			for _, group := range b.helpers.getExtraGroups(pos) {
+				np = append(np, extraNeighborsPotential{group})
+			}
+			for _, f := range np {
+				inc, helperNeighbors := f.getNeighborsPotentialValues(b.helpers)
+				neighborsPotentialValue := b.getNeighborsPotentialValues(&pos, helperNeighbors, inc)
+				value = neighborsPotentialValue.getPotentialValues(potentialValues)
+
 				if value != 0 {
 					b.setValue(pos, value)
+					b.trace(pos, TechniqueHiddenSingle, unitName(f))
 					stepChanges++
-					continue
+					break
 				}
-				flat := flatNeighborsPotential{pos}
-				streetY := streetYNeighborsPotential{pos}
-				streetX := streetXNeighborsPotential{pos}
-				np = []neighborsPotential{flat, streetY, streetX}
-				for _, f := range np {
-					inc, helperNeighbors := f.getNeighborsPotentialValues(b.helpers)
-					neighborsPotentialValue := b.getNeighborsPotentialValues(&pos, helperNeighbors, inc)
-					value = neighborsPotentialValue.getPotentialValues(potentialValues)
-
-					if value != 0 {
-						b.setValue(pos, value)
-						stepChanges++
-						break
-					}
 
-				}
 			}
 		}
-		if stepChanges == 0 {
-			break
-		}
-		step++
 	}
-	b.Steps = step
-	b.Elapsed = time.Since(start)
-	return b.isSolved()
+	return stepChanges
 }
 
 // String returns the board as string
@@ -153,22 +198,38 @@ func (b *Board) String() (res string) {
 	var buffer bytes.Buffer
 
 	for pos := 0; pos < b.helpers.boardSize; pos++ {
-		buffer.WriteString(strconv.Itoa(b.data[pos].value))
+		buffer.WriteByte(b.helpers.getSymbol(b.data[pos].value))
 	}
 	return buffer.String()
 }
 
 // NicePrint print the sudoku human representation
 func (b *Board) NicePrint() string {
+	return b.NicePrintWith(PrintOptions{})
+}
+
+// nicePrintTable returns the NicePrint of a table style with the parity,
+// markers and outside clues
+func (b *Board) nicePrintTable(table [25]string) string {
 	output := []interface{}{}
 	for pos := 0; pos < b.helpers.boardSize; pos++ {
 		value := " "
 		if v := b.getValue(pos); v != 0 {
-			value = fmt.Sprintf("%d", v)
+			value = string(b.helpers.getSymbol(v))
 		}
 		output = append(output, value)
 	}
-	return fmt.Sprintf(b.helpers.generateNicePrint(), output...)
+	res := fmt.Sprintf(b.helpers.generateNicePrintTable(table), output...)
+	if b.hasParity() {
+		res = b.addParityShading(res)
+	}
+	if len(b.getMarkers()) > 0 {
+		res = b.addMarkersSymbols(res)
+	}
+	if len(b.getClues()) > 0 {
+		res = b.addCluesBorder(res)
+	}
+	return res
 }
 
 // IsSolved returns if the board is solved
@@ -183,7 +244,9 @@ func (b *Board) isSolved() (solved bool) {
 	return true
 }
 
-// IsValid returns if the board is valid
+// IsValid returns if the board is valid: no value repeated in a unit or
+// breaking its parity, a marker or an outside clue. Validate reports the
+// same rules.
 func (b *Board) IsValid() (solved bool) {
 	for pos := 0; pos < b.helpers.boardSize; pos++ {
 		r := unique(b.getFlatNeighborsValues(pos))
@@ -201,6 +264,21 @@ func (b *Board) IsValid() (solved bool) {
 			return false
 		}
 	}
+	for _, group := range b.helpers.extraGroups {
+		if !unique(b.getNeighborsValues(group[0], group, 0, false)) {
+			return false
+		}
+	}
+	for pos := 0; pos < b.helpers.boardSize; pos++ {
+		if value := b.getValue(pos); value != 0 && !b.data[pos].parity.allows(value) {
+			return false
+		}
+	}
+	for _, c := range b.constraints {
+		if !c.isValid(b) {
+			return false
+		}
+	}
 	return true
 }
 
@@ -219,6 +297,16 @@ func unique(intSlice []int) bool {
 	return true
 }
 
+// contains returns if a value is on a list
+func contains(intSlice []int, value int) bool {
+	for _, entry := range intSlice {
+		if entry == value {
+			return true
+		}
+	}
+	return false
+}
+
 // getValue returns the cell value
 func (b *Board) getValue(pos int) (value int) {
 	return b.data[pos].value
@@ -242,11 +330,42 @@ func (b *Board) setPotential(pos int, values []int) {
 	b.data[pos].potential = values
 }
 
+// getCandidates returns the values a cell can still take
+func (b *Board) getCandidates(pos int) []int {
+	if value := b.getValue(pos); value != 0 {
+		return []int{value}
+	}
+	_, potentialValues := b.getAllNeighborsValues(pos).getPotentialValues(b.helpers.validValues)
+	return potential(b.getPotential(pos)).intersect(potentialValues)
+}
+
+// prunePotential keeps only the allowed candidates of an empty cell, returns 1 when it changes
+func (b *Board) prunePotential(pos int, allowed []int) int {
+	if b.getValue(pos) != 0 {
+		return 0
+	}
+	candidates := b.getCandidates(pos)
+	values := []int{}
+	for _, value := range candidates {
+		if contains(allowed, value) {
+			values = append(values, value)
+		}
+	}
+	if len(values) == len(candidates) {
+		return 0
+	}
+	b.setPotential(pos, values)
+	return 1
+}
+
 // getAllNeighborsValues returns all neighbors values
 func (b *Board) getAllNeighborsValues(pos int) (n neighbors) {
 	n = b.getFlatNeighborsValues(pos)
 	n = append(n, b.getStreetYNeighborsValues(pos)...)
 	n = append(n, b.getStreetXNeighborsValues(pos)...)
+	for _, group := range b.helpers.getExtraGroups(pos) {
+		n = append(n, b.getNeighborsValues(pos, group, 0, false)...)
+	}
 	return n
 }
 
@@ -5,55 +5,69 @@ import (
 	"testing"
 )
 
-func test3x3BoardUnsolved() (b Board) {
+func test3x3BoardUnsolved() (b *Board) {
 	helper := NewHelperBoard(3)
 	board := NewBoard(helper)
 	_ = board.LoadFromString("004300209005009001070060043006002087190007400050083000600000105003508690042910300")
 	return board
 }
 
-func test3x3BoardImpossible() (b Board) {
+func test3x3BoardImpossible() (b *Board) {
 	helper := NewHelperBoard(3)
 	board := NewBoard(helper)
 	_ = board.LoadFromString("800000000003600000070090200050007000000045700000100030001000068008500010090000400")
 	return board
 }
 
-func test2x2BoardSolved() (b Board) {
+func test2x2BoardSolved() (b *Board) {
 	helper := NewHelperBoard(2)
 	board := NewBoard(helper)
 	_ = board.LoadFromString("1234341221434321")
 	return board
 }
 
-func test2x2BoardInvalidFlat() (b Board) {
+func test2x2BoardInvalidFlat() (b *Board) {
 	helper := NewHelperBoard(2)
 	board := NewBoard(helper)
 	_ = board.LoadFromString("1234141221434321")
 	return board
 }
 
-func test2x2BoardInvalidY() (b Board) {
+func test2x2BoardInvalidY() (b *Board) {
 	helper := NewHelperBoard(2)
 	board := NewBoard(helper)
 	_ = board.LoadFromString("1214341221434321")
 	return board
 }
 
-func test2x2BoardInvalidX() (b Board) {
+func test2x2BoardInvalidX() (b *Board) {
 	helper := NewHelperBoard(2)
 	board := NewBoard(helper)
 	_ = board.LoadFromString("1234341211434321")
 	return board
 }
 
+func test4x4BoardUnsolved() (b *Board) {
+	helper := NewHelperBoard(4)
+	board := NewBoard(helper)
+	_ = board.LoadFromString("023456709ABCDE0G567890BCDEFG02349AB0DEFG12045678D0FG123406789AB023456709ABCDE0G167890BCDEFG02345AB0DEFG1204567890FG123406789AB0D3456709ABCDE0G127890BCDEFG023456B0DEFG1204567890FG123406789AB0DE456709ABCDE0G123890BCDEFG02345670DEFG1204567890BG123406789AB0DEF")
+	return board
+}
+
+func test2x2BoardHyper() (b *Board) {
+	helper := NewHelperBoard(2).WithHyper()
+	board := NewBoard(helper)
+	_ = board.LoadFromString("0040000103002000")
+	return board
+}
+
 func TestBoard_LoadFromString(t *testing.T) {
 	type args struct {
 		board string
 	}
 	tests := []struct {
 		name    string
-		b       Board
+		b       *Board
 		args    args
 		wantErr bool
 	}{
@@ -88,7 +102,7 @@ func TestBoard_LoadFromString(t *testing.T) {
 func TestBoard_String(t *testing.T) {
 	tests := []struct {
 		name    string
-		b       Board
+		b       *Board
 		wantRes string
 	}{
 		{
@@ -101,6 +115,21 @@ func TestBoard_String(t *testing.T) {
 			b:       NewBoard(test2x2Board()),
 			wantRes: "0000000000000000",
 		},
+		{
+			name:    "4x4",
+			b:       test4x4BoardUnsolved(),
+			wantRes: "023456709ABCDE0G567890BCDEFG02349AB0DEFG12045678D0FG123406789AB023456709ABCDE0G167890BCDEFG02345AB0DEFG1204567890FG123406789AB0D3456709ABCDE0G127890BCDEFG023456B0DEFG1204567890FG123406789AB0DE456709ABCDE0G123890BCDEFG02345670DEFG1204567890BG123406789AB0DEF",
+		},
+		{
+			name: "2x2 alphabet",
+			b: func() *Board {
+				h, _ := NewHelperBoard(2).WithAlphabet("ABCD")
+				b := NewBoard(h)
+				_ = b.LoadFromString("AB.DCDAB.ABDDCBA")
+				return b
+			}(),
+			wantRes: "AB0DCDAB0ABDDCBA",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -114,7 +143,7 @@ func TestBoard_String(t *testing.T) {
 func TestBoard_isSolved(t *testing.T) {
 	tests := []struct {
 		name       string
-		b          Board
+		b          *Board
 		wantSolved bool
 	}{
 		{
@@ -144,7 +173,7 @@ func TestBoard_getValue(t *testing.T) {
 
 	tests := []struct {
 		name string
-		b    Board
+		b    *Board
 		args args
 		want int
 	}{
@@ -172,7 +201,7 @@ func TestBoard_setValue(t *testing.T) {
 
 	tests := []struct {
 		name string
-		b    Board
+		b    *Board
 		args args
 		want int
 	}{
@@ -200,7 +229,7 @@ func TestBoard_getPotential(t *testing.T) {
 
 	tests := []struct {
 		name string
-		b    Board
+		b    *Board
 		args args
 		want []int
 	}{
@@ -228,7 +257,7 @@ func TestBoard_setPotential(t *testing.T) {
 
 	tests := []struct {
 		name string
-		b    Board
+		b    *Board
 		args args
 		want []int
 	}{
@@ -256,7 +285,7 @@ func TestBoard_getAllNeighborsValues(t *testing.T) {
 
 	tests := []struct {
 		name string
-		b    Board
+		b    *Board
 		args args
 		want neighbors
 	}{
@@ -283,7 +312,7 @@ func TestBoard_Solve(t *testing.T) {
 
 	tests := []struct {
 		name string
-		b    Board
+		b    *Board
 		want bool
 	}{
 		{
@@ -296,6 +325,16 @@ func TestBoard_Solve(t *testing.T) {
 			b:    test3x3BoardImpossible(),
 			want: false,
 		},
+		{
+			name: "2x2 hyper",
+			b:    test2x2BoardHyper(),
+			want: true,
+		},
+		{
+			name: "4x4",
+			b:    test4x4BoardUnsolved(),
+			want: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -340,7 +379,7 @@ func Test_unique(t *testing.T) {
 func TestBoard_isValid(t *testing.T) {
 	tests := []struct {
 		name      string
-		b         Board
+		b         *Board
 		wantValid bool
 	}{
 		{
@@ -363,6 +402,25 @@ func TestBoard_isValid(t *testing.T) {
 			b:         test2x2BoardInvalidX(),
 			wantValid: false,
 		},
+		{
+			name:      "2x2 hyper",
+			b:         func() *Board { b := test2x2BoardSolved(); b.helpers = b.helpers.WithHyper(); return b }(),
+			wantValid: false,
+		},
+		{
+			name:      "2x2 disjoint groups",
+			b:         func() *Board { b := test2x2BoardSolved(); b.helpers = b.helpers.WithDisjointGroups(); return b }(),
+			wantValid: true,
+		},
+		{
+			name: "2x2 disjoint groups",
+			b: func() *Board {
+				b := NewBoard(NewHelperBoard(2).WithDisjointGroups())
+				_ = b.LoadFromString("1243342143122134")
+				return b
+			}(),
+			wantValid: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -376,7 +434,7 @@ func TestBoard_isValid(t *testing.T) {
 func TestBoard_NicePrint(t *testing.T) {
 	tests := []struct {
 		name string
-		b    Board
+		b    *Board
 		res  string
 	}{
 		{
@@ -0,0 +1,182 @@
+package sodogo
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Booklet page sizes, an A4 page with 2cm margins
+const (
+	bookletWidth  = 170.0 // text width in mm
+	bookletHeight = 240.0 // text height in mm, without the page header
+	bookletGap    = 10.0  // space between puzzles in mm
+)
+
+// BookletPuzzle a booklet puzzle, the Solution is calculated when not set
+type BookletPuzzle struct {
+	ID       string
+	Grade    string
+	Board    *Board
+	Solution *Board
+}
+
+// BookletOptions booklet layout options, zero values use the defaults
+type BookletOptions struct {
+	Title   string // booklet title, "Sudoku" by default
+	PerPage int    // puzzles per page, 4 by default
+	Columns int    // puzzles per row, 2 by default
+}
+
+// WriteLaTeXBooklet writes a LaTeX booklet with the puzzles, their ID and
+// grade, and the solutions at the back. Puzzles the solver can not finish
+// need a Solution.
+func WriteLaTeXBooklet(w io.Writer, puzzles []BookletPuzzle, opts BookletOptions) error {
+	opts = opts.withDefaults()
+	solutions := make([]*Board, len(puzzles))
+	for num, p := range puzzles {
+		if p.Solution != nil {
+			solutions[num] = p.Solution
+			continue
+		}
+		solutions[num] = p.Board.Clone()
+		if !solutions[num].Solve() {
+			return fmt.Errorf("Puzzle %q can not be solved, set its Solution", p.ID)
+		}
+	}
+
+	var buffer bytes.Buffer
+	buffer.WriteString("\\documentclass[a4paper]{article}\n")
+	buffer.WriteString("\\usepackage[margin=2cm]{geometry}\n")
+	buffer.WriteString("\\pagestyle{empty}\n")
+	buffer.WriteString("\\setlength{\\parindent}{0pt}\n")
+	buffer.WriteString("\\begin{document}\n")
+	writeBookletPages(&buffer, opts.Title, puzzles, func(num int) *Board { return puzzles[num].Board }, opts)
+	writeBookletPages(&buffer, opts.Title+" -- Solutions", puzzles, func(num int) *Board { return solutions[num] }, opts)
+	buffer.WriteString("\\end{document}\n")
+
+	_, err := w.Write(buffer.Bytes())
+	return err
+}
+
+// writeBookletPages writes the pages of the boards, PerPage boards per page
+func writeBookletPages(buffer *bytes.Buffer, title string, puzzles []BookletPuzzle, board func(num int) *Board, opts BookletOptions) {
+	rows := (opts.PerPage + opts.Columns - 1) / opts.Columns
+	width := (bookletWidth - bookletGap*float64(opts.Columns-1)) / float64(opts.Columns)
+	height := (bookletHeight-bookletGap*float64(rows-1))/float64(rows) - 8 // caption space
+
+	for num, p := range puzzles {
+		if num%opts.PerPage == 0 {
+			if num != 0 {
+				buffer.WriteString("\\newpage\n")
+			}
+			fmt.Fprintf(buffer, "{\\Large\\bfseries %s}\\par\\vspace{5mm}\n", escapeLaTeX(title))
+		} else if num%opts.Columns == 0 {
+			fmt.Fprintf(buffer, "\\par\\vspace{%.0fmm}\n", bookletGap)
+		} else {
+			fmt.Fprintf(buffer, "\\hspace{%.0fmm}", bookletGap)
+		}
+		b := board(num)
+		cell := width / float64(b.helpers.maxValue)
+		if side := height / float64(b.helpers.maxValue); side < cell {
+			cell = side
+		}
+		fmt.Fprintf(buffer, "\\begin{minipage}[t]{%.1fmm}\n", width)
+		fmt.Fprintf(buffer, "\\textbf{%s}\\hfill %s\\par\\vspace{2mm}\n", escapeLaTeX(p.ID), escapeLaTeX(p.Grade))
+		b.writeLaTeXGrid(buffer, cell)
+		buffer.WriteString("\\end{minipage}")
+	}
+	buffer.WriteString("\n\\newpage\n")
+}
+
+// writeLaTeXGrid writes the board as a LaTeX picture, cell is the cell side in mm
+func (b *Board) writeLaTeXGrid(buffer *bytes.Buffer, cell float64) {
+	maxValue := b.helpers.maxValue
+
+	fmt.Fprintf(buffer, "\\setlength{\\unitlength}{%.2fmm}\n", cell)
+	fmt.Fprintf(buffer, "\\begin{picture}(%d,%d)\n", maxValue, maxValue)
+	fontSize := cell * 0.6 * 2.845 // mm to pt
+	fmt.Fprintf(buffer, "\\fontsize{%.1f}{%.1f}\\selectfont\n", fontSize, fontSize)
+	for pos := 0; pos < b.helpers.boardSize; pos++ {
+		value := b.getValue(pos)
+		if value == 0 {
+			continue
+		}
+		symbol := escapeLaTeX(string(b.helpers.getSymbol(value)))
+		if !b.data[pos].given {
+			symbol = "\\textit{" + symbol + "}"
+		}
+		fmt.Fprintf(buffer, "\\put(%d.5,%d.5){\\makebox(0,0){%s}}\n", pos%maxValue, maxValue-pos/maxValue-1, symbol)
+	}
+
+	// thin lines first, region borders are drawn over them
+	var thin, borders bytes.Buffer
+	line := func(w *bytes.Buffer, x int, y int, vertical bool) {
+		thickness, direction := "0.3pt", "1,0"
+		if w == &borders {
+			thickness = "1.2pt"
+		}
+		if vertical {
+			direction = "0,1"
+		}
+		fmt.Fprintf(w, "\\linethickness{%s}\\put(%d,%d){\\line(%s){1}}\n", thickness, x, y, direction)
+	}
+	for pos := 0; pos < b.helpers.boardSize; pos++ {
+		row, col := pos/maxValue, pos%maxValue
+		right, bottom := &thin, &thin
+		if col == maxValue-1 || b.helpers.flatGroups[pos] != b.helpers.flatGroups[pos+1] {
+			right = &borders
+		}
+		if row == maxValue-1 || b.helpers.flatGroups[pos] != b.helpers.flatGroups[pos+maxValue] {
+			bottom = &borders
+		}
+		line(right, col+1, maxValue-row-1, true)
+		line(bottom, col, maxValue-row-1, false)
+		if col == 0 {
+			line(&borders, col, maxValue-row-1, true)
+		}
+		if row == 0 {
+			line(&borders, col, maxValue, false)
+		}
+	}
+	buffer.Write(thin.Bytes())
+	buffer.Write(borders.Bytes())
+	buffer.WriteString("\\end{picture}\n")
+}
+
+// withDefaults returns the options with the default values of the unset fields
+func (opts BookletOptions) withDefaults() BookletOptions {
+	if opts.Title == "" {
+		opts.Title = "Sudoku"
+	}
+	if opts.PerPage <= 0 {
+		opts.PerPage = 4
+	}
+	if opts.Columns <= 0 {
+		opts.Columns = 2
+	}
+	if opts.Columns > opts.PerPage {
+		opts.Columns = opts.PerPage
+	}
+	return opts
+}
+
+// escapeLaTeX escapes the LaTeX special caracters
+func escapeLaTeX(text string) string {
+	return strings.NewReplacer(
+		"\\", "\\textbackslash{}",
+		"&", "\\&",
+		"%", "\\%",
+		"$", "\\$",
+		"#", "\\#",
+		"_", "\\_",
+		"{", "\\{",
+		"}", "\\}",
+		"~", "\\textasciitilde{}",
+		"^", "\\textasciicircum{}",
+		"<", "\\textless{}",
+		">", "\\textgreater{}",
+		"|", "\\textbar{}",
+	).Replace(text)
+}
@@ -0,0 +1,128 @@
+package sodogo
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestWriteLaTeXBooklet(t *testing.T) {
+	puzzle := func() *Board {
+		b := NewBoard(NewHelperBoard(2))
+		_ = b.LoadFromString("1030300201034020")
+		return b
+	}
+	solution := test2x2BoardSolved()
+
+	tests := []struct {
+		name    string
+		puzzles []BookletPuzzle
+		opts    BookletOptions
+		want    []string
+		wantN   map[string]int
+		wantErr bool
+	}{
+		{
+			name: "2x2 solved by the exporter",
+			puzzles: []BookletPuzzle{
+				{ID: "kids_1", Grade: "Easy & fun", Board: puzzle()},
+			},
+			want: []string{
+				"\\documentclass[a4paper]{article}",
+				"{\\Large\\bfseries Sudoku}",
+				"{\\Large\\bfseries Sudoku -- Solutions}",
+				"\\textbf{kids\\_1}\\hfill Easy \\& fun",
+				"\\begin{picture}(4,4)",
+				"\\put(0.5,3.5){\\makebox(0,0){1}}",
+				"\\put(1.5,3.5){\\makebox(0,0){\\textit{2}}}",
+				"\\end{document}",
+			},
+			wantN: map[string]int{
+				"\\begin{picture}": 2,
+				"\\newpage":        2,
+				"{1.2pt}":          2 * 24,
+				"{0.3pt}":          2 * 16,
+			},
+		},
+		{
+			name: "2x2 pages",
+			puzzles: []BookletPuzzle{
+				{ID: "1", Board: puzzle()},
+				{ID: "2", Board: puzzle()},
+				{ID: "3", Board: puzzle()},
+			},
+			opts: BookletOptions{Title: "Kids", PerPage: 2, Columns: 1},
+			want: []string{
+				"{\\Large\\bfseries Kids}",
+				"\\par\\vspace{10mm}",
+			},
+			wantN: map[string]int{
+				"\\begin{picture}":        6,
+				"\\newpage":               4,
+				"{\\Large\\bfseries Kids": 4,
+			},
+		},
+		{
+			name: "2x2 given solution",
+			puzzles: []BookletPuzzle{
+				{ID: "1", Board: NewBoard(NewHelperBoard(2)), Solution: solution},
+			},
+			wantN: map[string]int{
+				"\\makebox": 16,
+			},
+		},
+		{
+			name: "3x3 unsolvable",
+			puzzles: []BookletPuzzle{
+				{ID: "hard", Board: test3x3BoardImpossible()},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buffer bytes.Buffer
+			err := WriteLaTeXBooklet(&buffer, tt.puzzles, tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("WriteLaTeXBooklet() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			res := buffer.String()
+			for _, want := range tt.want {
+				if !strings.Contains(res, want) {
+					t.Errorf("WriteLaTeXBooklet() res does not contain %v\n%v", want, res)
+				}
+			}
+			for want, count := range tt.wantN {
+				if n := strings.Count(res, want); n != count {
+					t.Errorf("WriteLaTeXBooklet() %v count = %v, want %v", want, n, count)
+				}
+			}
+		})
+	}
+
+	// the puzzles are not solved by the exporter
+	b := puzzle()
+	_ = WriteLaTeXBooklet(&bytes.Buffer{}, []BookletPuzzle{{Board: b}}, BookletOptions{})
+	if res := b.String(); res != "1030300201034020" {
+		t.Errorf("WriteLaTeXBooklet() puzzle = %v, want 1030300201034020", res)
+	}
+}
+
+func Test_escapeLaTeX(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{text: "Easy", want: "Easy"},
+		{text: "50% #1 a_b", want: "50\\% \\#1 a\\_b"},
+		{text: "{~^\\}", want: "\\{\\textasciitilde{}\\textasciicircum{}\\textbackslash{}\\}"},
+		{text: "<|>", want: "\\textless{}\\textbar{}\\textgreater{}"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			if res := escapeLaTeX(tt.text); res != tt.want {
+				t.Errorf("escapeLaTeX() = %v, want %v", res, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,333 @@
+package sodogo
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Position row and column of a cell
+type Position struct {
+	Row int
+	Col int
+}
+
+// String returns the position as r1c1, rows and columns from 1
+func (p Position) String() string {
+	return fmt.Sprintf("r%dc%d", p.Row+1, p.Col+1)
+}
+
+// XV marker sums
+const (
+	X = 10
+	V = 5
+)
+
+// Marker a relation between two neighbor cells
+type Marker interface {
+	constraint
+	cells() (Position, Position)
+	symbol() string // marker representation, from the first cell to the second one
+}
+
+// GreaterThan the First cell value is greater than the Second cell value
+type GreaterThan struct {
+	First  Position
+	Second Position
+}
+
+// XV the First and the Second cell values add up to Sum, X (10) or V (5)
+type XV struct {
+	First  Position
+	Second Position
+	Sum    int
+}
+
+// xvNegative neighbor cells without XV marker do not add up to X or V
+type xvNegative struct{}
+
+// AddMarkers adds relations between neighbor cells to the board
+func (b *Board) AddMarkers(markers ...Marker) error {
+	for _, marker := range markers {
+		first, second := marker.cells()
+		if !b.inBoard(first) || !b.inBoard(second) {
+			return fmt.Errorf("Marker cells %v %v out of the board", first, second)
+		}
+		if abs(first.Row-second.Row)+abs(first.Col-second.Col) != 1 {
+			return fmt.Errorf("Marker cells %v %v are not neighbors", first, second)
+		}
+		if xv, ok := marker.(XV); ok && xv.Sum != X && xv.Sum != V {
+			return fmt.Errorf("Invalid XV sum %d at %v %v, valid sums are %d (V) and %d (X)", xv.Sum, first, second, V, X)
+		}
+		b.constraints = append(b.constraints, marker)
+	}
+	return nil
+}
+
+// AddXVNegative forbids neighbor cells without XV marker to add up to X or V
+func (b *Board) AddXVNegative() {
+	for _, c := range b.constraints {
+		if _, ok := c.(xvNegative); ok {
+			return
+		}
+	}
+	b.constraints = append(b.constraints, xvNegative{})
+}
+
+// getMarkers returns the board markers
+func (b *Board) getMarkers() (markers []Marker) {
+	for _, c := range b.constraints {
+		if marker, ok := c.(Marker); ok {
+			markers = append(markers, marker)
+		}
+	}
+	return markers
+}
+
+// addMarkersSymbols writes the markers between the cells of a NicePrint
+func (b *Board) addMarkersSymbols(nicePrint string) string {
+	lines := strings.Split(nicePrint, "\n")
+	for _, marker := range b.getMarkers() {
+		first, second := marker.cells()
+		symbol := marker.symbol()
+		if first.Row > second.Row || first.Col > second.Col {
+			first, second = second, first
+			symbol = reverseSymbol(symbol)
+		}
+		if first.Row != second.Row {
+			line := []rune(lines[first.Row*2+2])
+			copy(line[first.Col*4+1:], []rune(" "+verticalSymbol(symbol)+" "))
+			lines[first.Row*2+2] = string(line)
+			continue
+		}
+		line := []rune(lines[first.Row*2+1])
+		copy(line[second.Col*4:], []rune(symbol))
+		lines[first.Row*2+1] = string(line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// reverseSymbol returns the symbol read from the second cell to the first one
+func reverseSymbol(symbol string) string {
+	switch symbol {
+	case ">":
+		return "<"
+	case "<":
+		return ">"
+	}
+	return symbol
+}
+
+// verticalSymbol returns the symbol between a cell and the one below
+func verticalSymbol(symbol string) string {
+	switch symbol {
+	case ">":
+		return "v"
+	case "<":
+		return "^"
+	}
+	return symbol
+}
+
+// inBoard returns if a position is inside the board
+func (b *Board) inBoard(p Position) bool {
+	return p.Row >= 0 && p.Col >= 0 && p.Row < b.helpers.maxValue && p.Col < b.helpers.maxValue
+}
+
+// getPos returns the board position of a row and column
+func (b *Board) getPos(p Position) int {
+	return p.Row*b.helpers.maxValue + p.Col
+}
+
+// abs returns the absolute value
+func abs(value int) int {
+	if value < 0 {
+		return -value
+	}
+	return value
+}
+
+func (g GreaterThan) cells() (Position, Position) {
+	return g.First, g.Second
+}
+
+func (g GreaterThan) symbol() string {
+	return ">"
+}
+
+// prune keeps the First values over the lowest Second value and the Second
+// values under the highest First value
+func (g GreaterThan) prune(b *Board) (changes int) {
+	first, second := b.getPos(g.First), b.getPos(g.Second)
+	firstValues, secondValues := b.getCandidates(first), b.getCandidates(second)
+	if len(firstValues) == 0 || len(secondValues) == 0 {
+		return 0
+	}
+	firstAllowed, secondAllowed := []int{}, []int{}
+	for _, value := range firstValues {
+		if value > secondValues[0] {
+			firstAllowed = append(firstAllowed, value)
+		}
+	}
+	for _, value := range secondValues {
+		if value < firstValues[len(firstValues)-1] {
+			secondAllowed = append(secondAllowed, value)
+		}
+	}
+	return b.prunePotential(first, firstAllowed) + b.prunePotential(second, secondAllowed)
+}
+
+func (g GreaterThan) isValid(b *Board) bool {
+	first, second := b.getValue(b.getPos(g.First)), b.getValue(b.getPos(g.Second))
+	return first == 0 || second == 0 || first > second
+}
+
+func (g GreaterThan) conflicts(b *Board) []int {
+	if g.isValid(b) {
+		return nil
+	}
+	return []int{b.getPos(g.First), b.getPos(g.Second)}
+}
+
+func (g GreaterThan) breaks(b *Board, pos int) bool {
+	return (pos == b.getPos(g.First) || pos == b.getPos(g.Second)) && !g.isValid(b)
+}
+
+func (x XV) cells() (Position, Position) {
+	return x.First, x.Second
+}
+
+func (x XV) symbol() string {
+	if x.Sum == V {
+		return "V"
+	}
+	return "X"
+}
+
+// prune keeps the values with a complement on the other cell
+func (x XV) prune(b *Board) int {
+	first, second := b.getPos(x.First), b.getPos(x.Second)
+	firstValues, secondValues := b.getCandidates(first), b.getCandidates(second)
+	firstAllowed, secondAllowed := []int{}, []int{}
+	for _, value := range firstValues {
+		if value*2 != x.Sum && contains(secondValues, x.Sum-value) {
+			firstAllowed = append(firstAllowed, value)
+		}
+	}
+	for _, value := range secondValues {
+		if value*2 != x.Sum && contains(firstValues, x.Sum-value) {
+			secondAllowed = append(secondAllowed, value)
+		}
+	}
+	return b.prunePotential(first, firstAllowed) + b.prunePotential(second, secondAllowed)
+}
+
+func (x XV) isValid(b *Board) bool {
+	first, second := b.getValue(b.getPos(x.First)), b.getValue(b.getPos(x.Second))
+	return first == 0 || second == 0 || first+second == x.Sum
+}
+
+func (x XV) conflicts(b *Board) []int {
+	if x.isValid(b) {
+		return nil
+	}
+	return []int{b.getPos(x.First), b.getPos(x.Second)}
+}
+
+func (x XV) breaks(b *Board, pos int) bool {
+	return (pos == b.getPos(x.First) || pos == b.getPos(x.Second)) && !x.isValid(b)
+}
+
+// pairs returns the neighbor cells without XV marker
+func (n xvNegative) pairs(b *Board) (res [][2]int) {
+	marked := map[[2]int]bool{}
+	for _, marker := range b.getMarkers() {
+		if xv, ok := marker.(XV); ok {
+			first, second := b.getPos(xv.First), b.getPos(xv.Second)
+			marked[[2]int{first, second}] = true
+			marked[[2]int{second, first}] = true
+		}
+	}
+	for pos := 0; pos < b.helpers.boardSize; pos++ {
+		for _, next := range []int{pos + 1, pos + b.helpers.maxValue} {
+			if next == pos+1 && next%b.helpers.maxValue == 0 || next >= b.helpers.boardSize {
+				continue
+			}
+			if !marked[[2]int{pos, next}] {
+				res = append(res, [2]int{pos, next})
+			}
+		}
+	}
+	return res
+}
+
+// prune removes the X and V complements of the filled neighbors
+func (n xvNegative) prune(b *Board) (changes int) {
+	for _, pair := range n.pairs(b) {
+		for num, pos := range pair {
+			value := b.getValue(pos)
+			other := pair[1-num]
+			if value == 0 || b.getValue(other) != 0 {
+				continue
+			}
+			allowed := []int{}
+			for _, candidate := range b.getCandidates(other) {
+				if candidate+value != X && candidate+value != V {
+					allowed = append(allowed, candidate)
+				}
+			}
+			changes += b.prunePotential(other, allowed)
+		}
+	}
+	return changes
+}
+
+func (n xvNegative) conflicts(b *Board) (res []int) {
+	for _, pair := range n.pairs(b) {
+		first, second := b.getValue(pair[0]), b.getValue(pair[1])
+		if first != 0 && second != 0 && (first+second == X || first+second == V) {
+			res = append(res, pair[0], pair[1])
+		}
+	}
+	return res
+}
+
+// breaks checks the neighbors of the cell without XV marker
+func (n xvNegative) breaks(b *Board, pos int) bool {
+	value := b.getValue(pos)
+	if value == 0 {
+		return false
+	}
+	marked := map[int]bool{}
+	for _, marker := range b.getMarkers() {
+		if xv, ok := marker.(XV); ok {
+			first, second := b.getPos(xv.First), b.getPos(xv.Second)
+			if first == pos {
+				marked[second] = true
+			}
+			if second == pos {
+				marked[first] = true
+			}
+		}
+	}
+	row, col := pos/b.helpers.maxValue, pos%b.helpers.maxValue
+	for _, next := range []Position{{row - 1, col}, {row + 1, col}, {row, col - 1}, {row, col + 1}} {
+		if !b.inBoard(next) || marked[b.getPos(next)] {
+			continue
+		}
+		other := b.getValue(b.getPos(next))
+		if other != 0 && (value+other == X || value+other == V) {
+			return true
+		}
+	}
+	return false
+}
+
+func (n xvNegative) isValid(b *Board) bool {
+	for _, pair := range n.pairs(b) {
+		first, second := b.getValue(pair[0]), b.getValue(pair[1])
+		if first != 0 && second != 0 && (first+second == X || first+second == V) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,190 @@
+package sodogo
+
+import (
+	"reflect"
+	"testing"
+)
+
+// test2x2BoardComparison returns an empty board with greater than markers
+// between every neighbor cells of the 2x2 solved board
+func test2x2BoardComparison() (b *Board) {
+	solved := "1234341221434321"
+	b = NewBoard(NewHelperBoard(2))
+	_ = b.LoadFromString("0000000000000000")
+	for pos := range solved {
+		first := Position{pos / 4, pos % 4}
+		for _, second := range []Position{{first.Row, first.Col + 1}, {first.Row + 1, first.Col}} {
+			if second.Row > 3 || second.Col > 3 {
+				continue
+			}
+			if solved[pos] > solved[second.Row*4+second.Col] {
+				_ = b.AddMarkers(GreaterThan{first, second})
+			} else {
+				_ = b.AddMarkers(GreaterThan{second, first})
+			}
+		}
+	}
+	return b
+}
+
+func TestBoard_AddMarkers(t *testing.T) {
+	tests := []struct {
+		name    string
+		marker  Marker
+		wantErr bool
+	}{
+		{
+			name:    "greater than",
+			marker:  GreaterThan{Position{0, 0}, Position{0, 1}},
+			wantErr: false,
+		},
+		{
+			name:    "xv",
+			marker:  XV{Position{1, 0}, Position{0, 0}, X},
+			wantErr: false,
+		},
+		{
+			name:    "not neighbors",
+			marker:  GreaterThan{Position{0, 0}, Position{1, 1}},
+			wantErr: true,
+		},
+		{
+			name:    "out of the board",
+			marker:  XV{Position{3, 3}, Position{3, 4}, V},
+			wantErr: true,
+		},
+		{
+			name:    "xv sum",
+			marker:  XV{Position{0, 0}, Position{0, 1}, 7},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewBoard(NewHelperBoard(2))
+			if err := b.AddMarkers(tt.marker); (err != nil) != tt.wantErr {
+				t.Errorf("Board.AddMarkers() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(b.getMarkers()) != boolToInt(!tt.wantErr) {
+				t.Errorf("Board.AddMarkers() markers = %v, wantErr %v", b.getMarkers(), tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMarker_prune(t *testing.T) {
+	tests := []struct {
+		name        string
+		board       string
+		marker      constraint
+		wantChanges int
+		want        map[int][]int
+	}{
+		{
+			name:        "greater than",
+			board:       "0000000000000000",
+			marker:      GreaterThan{Position{0, 0}, Position{0, 1}},
+			wantChanges: 2,
+			want:        map[int][]int{0: {2, 3, 4}, 1: {1, 2, 3}},
+		},
+		{
+			name:        "greater than given",
+			board:       "0300000000000000",
+			marker:      GreaterThan{Position{0, 0}, Position{0, 1}},
+			wantChanges: 1,
+			want:        map[int][]int{0: {4}},
+		},
+		{
+			name:        "v",
+			board:       "0000200000000000",
+			marker:      XV{Position{0, 0}, Position{1, 0}, V},
+			wantChanges: 1,
+			want:        map[int][]int{0: {3}},
+		},
+		{
+			name:        "negative",
+			board:       "1000000000000000",
+			marker:      xvNegative{},
+			wantChanges: 2,
+			want:        map[int][]int{1: {2, 3}, 4: {2, 3}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewBoard(NewHelperBoard(2))
+			_ = b.LoadFromString(tt.board)
+			if changes := tt.marker.prune(b); changes != tt.wantChanges {
+				t.Errorf("Marker.prune() = %v, want %v", changes, tt.wantChanges)
+			}
+			for pos, want := range tt.want {
+				if got := b.getPotential(pos); !reflect.DeepEqual(got, want) {
+					t.Errorf("Marker.prune() potential %d = %v, want %v", pos, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestMarker_isValid(t *testing.T) {
+	tests := []struct {
+		name          string
+		marker        constraint
+		want          bool
+		wantConflicts []int
+	}{
+		{
+			name:   "greater than",
+			marker: GreaterThan{Position{0, 1}, Position{0, 0}},
+			want:   true,
+		},
+		{
+			name:          "wrong greater than",
+			marker:        GreaterThan{Position{0, 0}, Position{0, 1}},
+			want:          false,
+			wantConflicts: []int{0, 1},
+		},
+		{
+			name:   "v",
+			marker: XV{Position{0, 1}, Position{0, 2}, V},
+			want:   true,
+		},
+		{
+			name:          "negative",
+			marker:        xvNegative{},
+			want:          false,
+			wantConflicts: []int{1, 2, 4, 8, 5, 6, 5, 9, 6, 10, 7, 11, 9, 10, 13, 14},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := test2x2BoardSolved()
+			if got := tt.marker.isValid(b); got != tt.want {
+				t.Errorf("Marker.isValid() = %v, want %v", got, tt.want)
+			}
+			if got := tt.marker.conflicts(b); !reflect.DeepEqual(got, tt.wantConflicts) {
+				t.Errorf("Marker.conflicts() = %v, want %v", got, tt.wantConflicts)
+			}
+			for pos := range b.data {
+				if got := tt.marker.breaks(b, pos); got != contains(tt.wantConflicts, pos) {
+					t.Errorf("Marker.breaks(%d) = %v, want %v", pos, got, !got)
+				}
+			}
+		})
+	}
+}
+
+func TestBoard_SolveComparison(t *testing.T) {
+	b := test2x2BoardComparison()
+	if res := b.Solve(); !res || b.String() != "1234341221434321" {
+		t.Errorf("Board.Solve() res = %v %v, wantRes %v %v", res, b.String(), true, "1234341221434321")
+	}
+}
+
+func TestBoard_NicePrintMarkers(t *testing.T) {
+	b := test2x2BoardSolved()
+	_ = b.AddMarkers(GreaterThan{Position{0, 1}, Position{0, 0}}, GreaterThan{Position{1, 0}, Position{0, 0}}, XV{Position{3, 2}, Position{3, 3}, V})
+	want := "╔═══╤═══╦═══╤═══╗\n║ 1 < 2 ║ 3 │ 4 ║\n╟ ^ ┼───╫───┼───╢\n║ 3 │ 4 ║ 1 │ 2 ║\n╠═══╪═══╬═══╪═══╣\n║ 2 │ 1 ║ 4 │ 3 ║\n╟───┼───╫───┼───╢\n║ 4 │ 3 ║ 2 V 1 ║\n╚═══╧═══╩═══╧═══╝\n"
+	if res := b.NicePrint(); res != want {
+		t.Errorf("Board.NicePrint() res = %v, res %v", res, want)
+	}
+}
@@ -0,0 +1,129 @@
+package sodogo
+
+import (
+	"fmt"
+)
+
+/*
+     Cell access example, rows and columns from 0
+
+  value, err := board.Get(0, 2)             // 4
+  err = board.Set(0, 0, 8)                  // enters 8, nil when it breaks no rule
+  candidates, err := board.Candidates(0, 1) // [1 6 8]
+  symbols := board.Alphabet()               // "123456789"
+  for _, unit := range board.Units() {      // every box, row, column and group
+      for _, p := range unit.Cells { ... }
+  }
+*/
+
+// Get returns the value of a cell, 0 when it is empty
+func (b *Board) Get(row, col int) (int, error) {
+	p := Position{row, col}
+	if !b.inBoard(p) {
+		return 0, fmt.Errorf("Cell %v is out of the board", p)
+	}
+	return b.getValue(b.getPos(p)), nil
+}
+
+// Set enters a value in a cell, 0 clears it. Givens, out of range values and
+// values repeated in a unit or breaking the cell parity, a marker or an
+// outside clue are rejected and the board is not modified.
+func (b *Board) Set(row, col, value int) error {
+	p := Position{row, col}
+	if !b.inBoard(p) {
+		return fmt.Errorf("Cell %v is out of the board", p)
+	}
+	if value < 0 || value > b.helpers.maxValue {
+		return fmt.Errorf("Invalid value %d, valid values are 0 to %d", value, b.helpers.maxValue)
+	}
+	pos := b.getPos(p)
+	if b.data[pos].given {
+		return fmt.Errorf("Cell %v is a given", p)
+	}
+	if value == 0 {
+		b.clearValue(pos)
+		return nil
+	}
+	symbol := b.helpers.getSymbol(value)
+	previous := b.data[pos]
+	b.setValue(pos, value)
+	if err := b.setError(b.validate(), p, symbol); err != nil {
+		b.data[pos] = previous
+		return err
+	}
+	return nil
+}
+
+// setError returns why the value of a cell breaks the rules of a Validate
+// report, nil when the cell follows them
+func (b *Board) setError(e *ValidationError, p Position, symbol byte) error {
+	if positionIn(e.Parity, p) {
+		return fmt.Errorf("Value %c breaks the parity of %v", symbol, p)
+	}
+	for _, d := range e.Duplicates {
+		if !positionIn(d.Positions, p) {
+			continue
+		}
+		other := d.Positions[0]
+		if other == p {
+			other = d.Positions[1]
+		}
+		return fmt.Errorf("Value %c conflicts with %v in %s %d", symbol, other, d.Unit.Kind, d.Unit.Index+1)
+	}
+	if positionIn(e.Rules, p) {
+		return fmt.Errorf("Value %c breaks a marker or outside clue at %v", symbol, p)
+	}
+	return nil
+}
+
+// positionIn returns if a position is on a list
+func positionIn(positions []Position, p Position) bool {
+	for _, entry := range positions {
+		if entry == p {
+			return true
+		}
+	}
+	return false
+}
+
+// Candidates returns the values an empty cell can still take, in order, or
+// the value of a filled cell
+func (b *Board) Candidates(row, col int) ([]int, error) {
+	p := Position{row, col}
+	if !b.inBoard(p) {
+		return nil, fmt.Errorf("Cell %v is out of the board", p)
+	}
+	return append([]int{}, b.getCandidates(b.getPos(p))...), nil
+}
+
+// IsGiven returns if a cell value was loaded with the puzzle, false for
+// cells out of the board
+func (b *Board) IsGiven(row, col int) bool {
+	p := Position{row, col}
+	return b.inBoard(p) && b.data[b.getPos(p)].given
+}
+
+// Alphabet returns the value symbols of the board, the symbol of value 1 first
+func (b *Board) Alphabet() string {
+	return b.helpers.alphabet
+}
+
+// Units returns every box, row, column and extra group of the board, in this
+// order
+func (b *Board) Units() []Unit {
+	units := b.helpers.getUnits()
+	res := make([]Unit, len(units))
+	for num, unit := range units {
+		res[num] = b.helpers.newUnit(num, unit)
+	}
+	return res
+}
+
+// clearValue empties a cell, its potential values are unknown again
+func (b *Board) clearValue(pos int) {
+	b.setValue(pos, 0)
+	b.setPotential(pos, potential{0})
+	if parity := b.data[pos].parity; parity != AnyParity {
+		b.setPotential(pos, b.helpers.getParityValues(parity))
+	}
+}
@@ -0,0 +1,183 @@
+package sodogo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func test2x2BoardCells() (b *Board) {
+	b = NewBoard(NewHelperBoard(2))
+	_ = b.LoadFromString("1200000000000000")
+	_ = b.LoadParityFromString("....e...........")
+	_ = b.AddMarkers(GreaterThan{Position{0, 3}, Position{0, 2}})
+	_ = b.Set(0, 2, 4)
+	return b
+}
+
+func TestBoard_Get(t *testing.T) {
+	tests := []struct {
+		name     string
+		row, col int
+		want     int
+		wantErr  bool
+	}{
+		{"given", 0, 1, 2, false},
+		{"set", 0, 2, 4, false},
+		{"empty", 3, 3, 0, false},
+		{"out of the board", 0, 4, 0, true},
+		{"negative", -1, 0, 0, true},
+	}
+	b := test2x2BoardCells()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := b.Get(tt.row, tt.col)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Board.Get() err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if res != tt.want {
+				t.Errorf("Board.Get() res = %v, want %v", res, tt.want)
+			}
+		})
+	}
+}
+
+func TestBoard_Set(t *testing.T) {
+	tests := []struct {
+		name            string
+		row, col, value int
+		wantErr         string
+		wantBoard       string
+	}{
+		{"set", 1, 0, 4, "", "1240400000000000"},
+		{"replace", 0, 2, 3, "", "1230000000000000"},
+		{"clear", 0, 2, 0, "", "1200000000000000"},
+		{"given", 0, 0, 3, "Cell r1c1 is a given", ""},
+		{"out of the board", 4, 0, 1, "Cell r5c1 is out of the board", ""},
+		{"out of range", 1, 0, 5, "Invalid value 5, valid values are 0 to 4", ""},
+		{"parity", 1, 0, 3, "Value 3 breaks the parity of r2c1", ""},
+		{"box", 1, 1, 1, "Value 1 conflicts with r1c1 in box 1", ""},
+		{"row", 0, 3, 1, "Value 1 conflicts with r1c1 in row 1", ""},
+		{"column", 2, 2, 4, "Value 4 conflicts with r1c3 in column 3", ""},
+		{"marker", 0, 3, 3, "Value 3 breaks a marker or outside clue at r1c4", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := test2x2BoardCells()
+			before := b.String()
+			err := b.Set(tt.row, tt.col, tt.value)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Errorf("Board.Set() err = %v, want %v", err, tt.wantErr)
+				}
+				if b.String() != before {
+					t.Errorf("Board.Set() board = %v, want %v", b.String(), before)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Board.Set() err = %v", err)
+			}
+			if b.String() != tt.wantBoard {
+				t.Errorf("Board.Set() board = %v, want %v", b.String(), tt.wantBoard)
+			}
+			if b.IsGiven(tt.row, tt.col) {
+				t.Errorf("Board.Set() made r%dc%d a given", tt.row+1, tt.col+1)
+			}
+		})
+	}
+}
+
+func TestBoard_Candidates(t *testing.T) {
+	tests := []struct {
+		name     string
+		row, col int
+		want     []int
+		wantErr  bool
+	}{
+		{"filled", 0, 2, []int{4}, false},
+		{"empty", 0, 3, []int{3}, false},
+		{"parity", 1, 0, []int{4}, false},
+		{"column", 3, 2, []int{1, 2, 3}, false},
+		{"out of the board", 4, 4, nil, true},
+	}
+	b := test2x2BoardCells()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := b.Candidates(tt.row, tt.col)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Board.Candidates() err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(res, tt.want) {
+				t.Errorf("Board.Candidates() res = %v, want %v", res, tt.want)
+			}
+		})
+	}
+}
+
+func TestBoard_IsGiven(t *testing.T) {
+	tests := []struct {
+		name     string
+		row, col int
+		want     bool
+	}{
+		{"given", 0, 0, true},
+		{"set", 0, 2, false},
+		{"empty", 2, 2, false},
+		{"out of the board", 0, -1, false},
+	}
+	b := test2x2BoardCells()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if res := b.IsGiven(tt.row, tt.col); res != tt.want {
+				t.Errorf("Board.IsGiven() res = %v, want %v", res, tt.want)
+			}
+		})
+	}
+}
+
+func TestBoard_Alphabet(t *testing.T) {
+	letters, _ := NewHelperBoard(2).WithAlphabet("ABCD")
+	if res := NewBoard(letters).Alphabet(); res != "ABCD" {
+		t.Errorf("Board.Alphabet() res = %v, want ABCD", res)
+	}
+	if res := test2x2BoardCells().Alphabet(); res != "1234" {
+		t.Errorf("Board.Alphabet() res = %v, want 1234", res)
+	}
+}
+
+func TestBoard_Units(t *testing.T) {
+	tests := []struct {
+		name      string
+		b         *Board
+		wantCount int
+		wantLast  Unit
+	}{
+		{
+			name:      "2x2",
+			b:         test2x2BoardSolved(),
+			wantCount: 12,
+			wantLast:  Unit{UnitColumn, 3, []Position{{0, 3}, {1, 3}, {2, 3}, {3, 3}}},
+		},
+		{
+			name:      "hyper",
+			b:         test2x2BoardHyper(),
+			wantCount: 13,
+			wantLast:  Unit{UnitGroup, 0, []Position{{1, 1}, {1, 2}, {2, 1}, {2, 2}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := tt.b.Units()
+			if len(res) != tt.wantCount {
+				t.Fatalf("Board.Units() count = %v, want %v", len(res), tt.wantCount)
+			}
+			want := Unit{UnitBox, 0, []Position{{0, 0}, {0, 1}, {1, 0}, {1, 1}}}
+			if !reflect.DeepEqual(res[0], want) {
+				t.Errorf("Board.Units() first = %+v, want %+v", res[0], want)
+			}
+			if !reflect.DeepEqual(res[len(res)-1], tt.wantLast) {
+				t.Errorf("Board.Units() last = %+v, want %+v", res[len(res)-1], tt.wantLast)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package sodogo
+
+import (
+	"reflect"
+)
+
+/*
+     Copy semantics example
+
+  h := NewHelperBoard(3)
+  board := NewBoard(h)   // a *Board, every method and function takes a *Board
+  same := board          // the same board, both see every change
+  clone := board.Clone() // independent copy, changes are not shared
+  board.Equal(clone)     // true until one of them changes
+  copied := *board       // reported by go vet, the cells would be shared
+*/
+
+// Clone returns an independent copy of the board: the cells, candidates,
+// parity and rules are copied, the helpers are shared as they never change.
+// Steps and Elapsed are kept, the solving trace is not.
+func (b *Board) Clone() (res *Board) {
+	res = NewBoard(b.helpers)
+	for pos, c := range b.data {
+		res.data[pos] = c
+		res.data[pos].potential = append(potential(nil), c.potential...)
+	}
+	res.constraints = append([]constraint(nil), b.constraints...)
+	res.Steps, res.Elapsed = b.Steps, b.Elapsed
+	return res
+}
+
+// Equal returns if both boards have the same size, extra groups, values,
+// givens, parity and rules. Candidates, symbols, Steps and Elapsed are not
+// compared.
+func (b *Board) Equal(other *Board) bool {
+	if b.helpers.maxValue != other.helpers.maxValue || len(b.data) != len(other.data) {
+		return false
+	}
+	if len(b.helpers.extraGroups) != len(other.helpers.extraGroups) ||
+		(len(b.helpers.extraGroups) > 0 && !reflect.DeepEqual(b.helpers.extraGroups, other.helpers.extraGroups)) {
+		return false
+	}
+	for pos, c := range b.data {
+		o := other.data[pos]
+		if c.value != o.value || c.given != o.given || c.parity != o.parity {
+			return false
+		}
+	}
+	if len(b.constraints) != len(other.constraints) {
+		return false
+	}
+	return len(b.constraints) == 0 || reflect.DeepEqual(b.constraints, other.constraints)
+}
@@ -0,0 +1,108 @@
+package sodogo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBoard_copySemantics(t *testing.T) {
+	b := test2x2BoardMidSolve()
+	same := b
+	res := b.Clone()
+	want, wantMarks := b.String(), b.PencilMarks()
+
+	// every cell of the clone changes, the board keeps its cells
+	_ = res.LoadFromString("0341200000000000")
+	_ = res.LoadParityFromString("oeoeoeoeoeoeoeoe")
+	res.solveStep()
+	if b.String() != want || b.PencilMarks() != wantMarks || b.hasParity() || b.data[1].given {
+		t.Errorf("Board.Clone() changes reached the board %v\n%v", b.String(), b.PencilMarks())
+	}
+
+	// and the other way around, a *Board copy is the same board
+	resWant, resMarks := res.String(), res.PencilMarks()
+	_ = same.LoadFromString("4000000000000001")
+	same.solveStep()
+	if res.String() != resWant || res.PencilMarks() != resMarks || res.data[0].given {
+		t.Errorf("Board.Clone() board changes reached the clone %v\n%v", res.String(), res.PencilMarks())
+	}
+	if b.String() != same.String() {
+		t.Errorf("a *Board copy res = %v, want %v", b.String(), same.String())
+	}
+}
+
+func TestBoard_Clone(t *testing.T) {
+	b := test2x2BoardCells()
+	b.Steps = 3
+	res := b.Clone()
+	if !res.Equal(b) || res.Steps != 3 {
+		t.Fatalf("Board.Clone() res = %v, want %v", res.String(), b.String())
+	}
+	if err := res.Set(0, 2, 0); err != nil {
+		t.Fatalf("Board.Set() err = %v", err)
+	}
+	res.getPotential(5)[0] = 3
+	if reflect.DeepEqual(b.getPotential(5), res.getPotential(5)) {
+		t.Errorf("Board.Clone() shares the potential values %v", b.getPotential(5))
+	}
+	if err := res.LoadParityFromString("o..............."); err != nil {
+		t.Fatalf("Board.LoadParityFromString() err = %v", err)
+	}
+	if b.String() != "1240000000000000" || b.data[0].parity != AnyParity {
+		t.Errorf("Board.Clone() changes reached the original board %v", b.String())
+	}
+	_ = res.AddMarkers(XV{Position{2, 2}, Position{2, 3}, 5})
+	if len(b.constraints) != 1 {
+		t.Errorf("Board.Clone() shares the constraints, %v", len(b.constraints))
+	}
+}
+
+func TestBoard_Equal(t *testing.T) {
+	solved := test2x2BoardSolved()
+	unsolved := test2x2BoardCells()
+	changed := unsolved.Clone()
+	_ = changed.Set(0, 2, 3)
+	parity := unsolved.Clone()
+	_ = parity.LoadParityFromString("o...e...........")
+	marker := unsolved.Clone()
+	_ = marker.AddMarkers(XV{Position{2, 2}, Position{2, 3}, 5})
+	given := NewBoard(NewHelperBoard(2))
+	_ = given.LoadFromString("1240000000000000")
+	_ = given.LoadParityFromString("....e...........")
+	_ = given.AddMarkers(GreaterThan{Position{0, 3}, Position{0, 2}})
+	hyper := NewBoard(NewHelperBoard(2).WithHyper())
+	_ = hyper.LoadFromString("1234341221434321")
+	alphabet, _ := NewHelperBoard(2).WithAlphabet("ABCD")
+	symbols := NewBoard(alphabet)
+	_ = symbols.LoadFromString("ABCDCDABBADCDCBA")
+	solving := unsolved.Clone()
+	solving.setPotential(5, []int{3})
+	solving.Steps = 2
+
+	tests := []struct {
+		name  string
+		b     *Board
+		other *Board
+		want  bool
+	}{
+		{"same", solved, test2x2BoardSolved(), true},
+		{"candidates and steps", unsolved, solving, true},
+		{"symbols", solved, symbols, true},
+		{"value", unsolved, changed, false},
+		{"given", unsolved, given, false},
+		{"parity", unsolved, parity, false},
+		{"marker", unsolved, marker, false},
+		{"extra groups", solved, hyper, false},
+		{"size", solved, test3x3BoardUnsolved(), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if res := tt.b.Equal(tt.other); res != tt.want {
+				t.Errorf("Board.Equal() res = %v, want %v", res, tt.want)
+			}
+			if res := tt.other.Equal(tt.b); res != tt.want {
+				t.Errorf("Board.Equal() reversed res = %v, want %v", res, tt.want)
+			}
+		})
+	}
+}
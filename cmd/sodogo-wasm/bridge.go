@@ -0,0 +1,54 @@
+// Command sodogo-wasm exposes the solver to JavaScript. Built with
+// GOOS=js GOARCH=wasm it sets a global sodogo object:
+//
+//	sodogo.solve(board, options)     solves the board
+//	sodogo.validate(board, options)  checks the board is well formed and without conflicts
+//	sodogo.grade(board, options)     grades the board: easy, medium, hard or unsolvable
+//	sodogo.hint(board, options)      returns the next cell the solver fills
+//	sodogo.generate(options)         generates a puzzle the solver can finish
+//
+// options is optional, {size: 3, hyper: false, disjoint: false, alphabet: "",
+// seed: 0} by default. The sizes have the HTTP/JSON API default limits, 2 to
+// 5 and 2 to 4 for generate. Every function returns an object like the
+// HTTP/JSON API responses: {status, board, steps, elapsed, grade, hint: {row,
+// col, value}, error}.
+package main
+
+import (
+	"context"
+
+	"github.com/rfiestas/sodogo/server/api"
+)
+
+// result call result, converted to a JavaScript object
+type result map[string]interface{}
+
+// handler runs the calls with the HTTP/JSON API default limits
+var handler = api.New(api.Limits{})
+
+// call runs an api endpoint, the errors are returned in the result
+func call(name string, req api.Request) result {
+	res, err := handler.Call(context.Background(), name, req)
+	if err != nil {
+		res = api.ErrorResponse(err)
+	}
+	return newResult(res)
+}
+
+// newResult returns the result of a response, without the empty fields like
+// the JSON responses
+func newResult(res api.Response) result {
+	r := result{}
+	for name, value := range map[string]string{"status": res.Status, "board": res.Board, "elapsed": res.Elapsed, "grade": res.Grade, "error": res.Error} {
+		if value != "" {
+			r[name] = value
+		}
+	}
+	if res.Steps != 0 {
+		r["steps"] = res.Steps
+	}
+	if res.Hint != nil {
+		r["hint"] = map[string]interface{}{"row": res.Hint.Row, "col": res.Hint.Col, "value": res.Hint.Value}
+	}
+	return r
+}
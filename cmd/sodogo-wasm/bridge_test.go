@@ -0,0 +1,136 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/rfiestas/sodogo/server/api"
+)
+
+const (
+	testPuzzle   = "004300209005009001070060043006002087190007400050083000600000105003508690042910300"
+	testSolution = "864371259325849761971265843436192587198657432257483916689734125713528694542916378"
+)
+
+func TestCalls(t *testing.T) {
+	tests := []struct {
+		name  string
+		call  string
+		board string
+		req   api.Request
+		want  result
+	}{
+		{
+			name:  "solve",
+			call:  "solve",
+			board: testPuzzle,
+			want:  result{"status": api.StatusSolved, "board": testSolution, "steps": 4},
+		},
+		{
+			name:  "solve 2x2",
+			call:  "solve",
+			board: "1.3.3..2.1.34.2.",
+			req:   api.Request{Size: 2},
+			want:  result{"status": api.StatusSolved, "board": "1234341221434321", "steps": 3},
+		},
+		{
+			name:  "solve invalid board",
+			call:  "solve",
+			board: "123",
+			want:  result{"status": api.StatusInvalid, "error": "A valid board text contains 81 cells, not 3"},
+		},
+		{
+			name:  "validate",
+			call:  "validate",
+			board: "1234341221434321",
+			req:   api.Request{Size: 2},
+			want:  result{"status": api.StatusValid, "board": "1234341221434321"},
+		},
+		{
+			name:  "validate hyper",
+			call:  "validate",
+			board: "1234341221434321",
+			req:   api.Request{Size: 2, Options: api.Options{Hyper: true}},
+			want:  result{"status": api.StatusInvalid, "error": "Given conflicts with position 6 '1' at position 9 (row 2, column 1)"},
+		},
+		{
+			name:  "validate no candidates",
+			call:  "validate",
+			board: "1200000300000004",
+			req:   api.Request{Size: 2},
+			want:  result{"status": api.StatusInvalid, "board": "1200000300000004", "error": "Cell r1c4 has no candidates"},
+		},
+		{
+			name:  "grade",
+			call:  "grade",
+			board: testPuzzle,
+			want:  result{"grade": "easy"},
+		},
+		{
+			name:  "hint",
+			call:  "hint",
+			board: testPuzzle,
+			want:  result{"hint": map[string]interface{}{"row": 0, "col": 0, "value": 8}},
+		},
+		{
+			name:  "hint solved",
+			call:  "hint",
+			board: testSolution,
+			want:  result{"status": api.StatusSolved},
+		},
+		{
+			name:  "alphabet",
+			call:  "solve",
+			board: "A.C.C..B.A.CD.B.",
+			req:   api.Request{Size: 2, Options: api.Options{Alphabet: "ABCD"}},
+			want:  result{"status": api.StatusSolved, "board": "ABCDCDABBADCDCBA", "steps": 3},
+		},
+		{
+			name:  "invalid alphabet",
+			call:  "solve",
+			board: "A.C.C..B.A.CD.B.",
+			req:   api.Request{Size: 2, Options: api.Options{Alphabet: "AB"}},
+			want:  result{"error": "A valid alphabet contains 4 symbols, not 2"},
+		},
+		{
+			name: "invalid size",
+			call: "grade",
+			req:  api.Request{Size: 9},
+			want: result{"error": "Invalid size 9, valid sizes are 2 to 5"},
+		},
+		{
+			name: "generate size",
+			call: "generate",
+			req:  api.Request{Size: 5},
+			want: result{"error": "Invalid size 5, valid generate sizes are 2 to 4"},
+		},
+		{
+			name: "generate hyper",
+			call: "generate",
+			req:  api.Request{Size: 2, Options: api.Options{Hyper: true}},
+			want: result{"error": "Boards with extra groups can not be generated"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.req.Board = tt.board
+			res := call(tt.call, tt.req)
+			delete(res, "elapsed")
+			if !reflect.DeepEqual(res, tt.want) {
+				t.Errorf("%s() res = %v, want %v", tt.call, res, tt.want)
+			}
+		})
+	}
+}
+
+func TestCalls_generate(t *testing.T) {
+	req := api.Request{Size: 2, Options: api.Options{Seed: 3}}
+	first, second := call("generate", req), call("generate", req)
+	if first["status"] != api.StatusGenerated || first["grade"] == "" || !reflect.DeepEqual(first, second) {
+		t.Errorf("generate() res = %v, want %v", second, first)
+	}
+	board, _ := first["board"].(string)
+	if res := call("solve", api.Request{Size: 2, Board: board}); res["status"] != api.StatusSolved {
+		t.Errorf("solve() generated res = %v", res)
+	}
+}
@@ -0,0 +1,50 @@
+//go:build js && wasm
+
+package main
+
+import (
+	"syscall/js"
+
+	"github.com/rfiestas/sodogo/server/api"
+)
+
+func main() {
+	sodogo := map[string]interface{}{}
+	for _, name := range api.Names() {
+		sodogo[name] = js.FuncOf(jsCall(name))
+	}
+	js.Global().Set("sodogo", js.ValueOf(sodogo))
+	// the functions are called from JavaScript while the program runs
+	select {}
+}
+
+// jsCall returns a JavaScript function calling an api endpoint, the
+// board is the first argument but for generate, the options the next one
+func jsCall(name string) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		req := api.Request{}
+		if name != "generate" && len(args) > 0 {
+			req.Board, args = args[0].String(), args[1:]
+		}
+		if len(args) > 0 && args[0].Type() == js.TypeObject {
+			jsOptions(args[0], &req)
+		}
+		return js.ValueOf(map[string]interface{}(call(name, req)))
+	}
+}
+
+// jsOptions sets the request size and options of a JavaScript object,
+// missing fields keep the defaults
+func jsOptions(v js.Value, req *api.Request) {
+	if size := v.Get("size"); size.Type() == js.TypeNumber {
+		req.Size = size.Int()
+	}
+	req.Options.Hyper = v.Get("hyper").Truthy()
+	req.Options.Disjoint = v.Get("disjoint").Truthy()
+	if alphabet := v.Get("alphabet"); alphabet.Type() == js.TypeString {
+		req.Options.Alphabet = alphabet.String()
+	}
+	if seed := v.Get("seed"); seed.Type() == js.TypeNumber {
+		req.Options.Seed = int64(seed.Float())
+	}
+}
@@ -0,0 +1,80 @@
+//go:build js && wasm
+
+package main
+
+import (
+	"syscall/js"
+	"testing"
+
+	"github.com/rfiestas/sodogo/server/api"
+)
+
+func TestJSCall(t *testing.T) {
+	tests := []struct {
+		name       string
+		call       string
+		args       []interface{}
+		wantStatus string
+		wantBoard  string
+		wantError  string
+	}{
+		{
+			name:       "solve",
+			call:       "solve",
+			args:       []interface{}{testPuzzle},
+			wantStatus: api.StatusSolved,
+			wantBoard:  testSolution,
+		},
+		{
+			name:       "solve with options",
+			call:       "solve",
+			args:       []interface{}{"A.C.C..B.A.CD.B.", map[string]interface{}{"size": 2, "alphabet": "ABCD"}},
+			wantStatus: api.StatusSolved,
+			wantBoard:  "ABCDCDABBADCDCBA",
+		},
+		{
+			name:       "validate hyper",
+			call:       "validate",
+			args:       []interface{}{"1234341221434321", map[string]interface{}{"size": 2, "hyper": true}},
+			wantStatus: api.StatusInvalid,
+			wantError:  "Given conflicts with position 6 '1' at position 9 (row 2, column 1)",
+		},
+		{
+			name:       "generate",
+			call:       "generate",
+			args:       []interface{}{map[string]interface{}{"size": 2, "seed": 3}},
+			wantStatus: api.StatusGenerated,
+		},
+		{
+			name:      "invalid size",
+			call:      "generate",
+			args:      []interface{}{map[string]interface{}{"size": 9}},
+			wantError: "Invalid size 9, valid sizes are 2 to 5",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := js.FuncOf(jsCall(tt.call))
+			defer f.Release()
+			res := f.Invoke(tt.args...)
+			if status := res.Get("status"); (status.IsUndefined() && tt.wantStatus != "") || (!status.IsUndefined() && status.String() != tt.wantStatus) {
+				t.Errorf("%s() status = %v, want %v", tt.call, status, tt.wantStatus)
+			}
+			if tt.wantBoard != "" && res.Get("board").String() != tt.wantBoard {
+				t.Errorf("%s() board = %v, want %v", tt.call, res.Get("board"), tt.wantBoard)
+			}
+			if tt.wantError != "" && res.Get("error").String() != tt.wantError {
+				t.Errorf("%s() error = %v, want %v", tt.call, res.Get("error"), tt.wantError)
+			}
+		})
+	}
+}
+
+func TestJSCall_hint(t *testing.T) {
+	f := js.FuncOf(jsCall("hint"))
+	defer f.Release()
+	hint := f.Invoke(testPuzzle).Get("hint")
+	if hint.Get("row").Int() != 0 || hint.Get("col").Int() != 0 || hint.Get("value").Int() != 8 {
+		t.Errorf("hint() res = %v, want r1c1 8", hint)
+	}
+}
@@ -0,0 +1,371 @@
+// Command sodogo solves, validates, generates, grades and converts sudokus,
+// plays them in the terminal and serves them as an HTTP/JSON API.
+//
+// Usage:
+//
+//	sodogo <command> [--size N] [--format F] [puzzle or file ...]
+//
+// Puzzles are read from the arguments, files or stdin, as a line per puzzle,
+// a text grid, a NicePrint or a SadMan .sdk file.
+//
+// Exit status: 0 solved or valid, 1 unsolvable, 2 invalid, 3 usage error.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/rfiestas/sodogo"
+	"github.com/rfiestas/sodogo/render"
+)
+
+// Exit status codes
+const (
+	exitSolved     = 0 // solved, valid or done
+	exitUnsolvable = 1 // the solver can not finish a puzzle
+	exitInvalid    = 2 // malformed puzzle or conflicting values
+	exitUsage      = 3 // wrong command, flags or unreadable files
+)
+
+// maxGenerateSize the greatest generated size, a 25x25 board takes seconds and
+// bigger ones minutes
+const maxGenerateSize = 4
+
+const usage = `Usage: sodogo <command> [flags] [puzzle or file ...]
+
+Commands:
+  solve      solve the puzzles
+  validate   check the puzzles are well formed and without conflicts
+  generate   generate puzzles the solver can finish
+  grade      grade the puzzles: easy, medium, hard or unsolvable
+  hint       show the next cell the solver fills
+  convert    write the puzzles in another format
+  play       play the first puzzle, or a generated one, in the terminal
+  serve      serve the HTTP/JSON API
+
+Flags:
+  --size N     flat size, 3 for 9x9 boards (default 3), generate and play
+               without a puzzle: 2 to 4
+  --format F   output format: line, pretty, ascii, compact, color, pencil,
+               sdk, ss, json, svg or png (default line), play: pretty, ascii
+               or color
+  --count N    generate: number of puzzles (default 1)
+  --seed N     generate and play: random seed (default the current time)
+  --addr A     serve: listen address (default :8080)
+  --timeout D  serve: request timeout (default 10s)
+  --max-concurrent N
+               serve: requests solving at the same time (default the CPUs)
+
+Puzzles are read from the arguments, files or stdin. play reads the keys of
+the terminal, or a command per line when stdin is not a terminal, ? shows them.
+Exit status: 0 solved or valid, 1 unsolvable, 2 invalid, 3 usage error.
+`
+
+// formats output formats
+var formats = map[string]func(w io.Writer, b *sodogo.Board) error{
+	"line":    func(w io.Writer, b *sodogo.Board) error { return writeString(w, b.String()+"\n") },
+	"pretty":  func(w io.Writer, b *sodogo.Board) error { return writeString(w, b.NicePrint()) },
+	"ascii":   printStyle(sodogo.PrintOptions{Style: sodogo.ASCIIStyle}),
+	"compact": printStyle(sodogo.PrintOptions{Style: sodogo.CompactStyle}),
+	"color":   printStyle(sodogo.PrintOptions{Colors: true}),
+	"pencil":  func(w io.Writer, b *sodogo.Board) error { return writeString(w, b.PencilMarks()) },
+	"sdk":     func(w io.Writer, b *sodogo.Board) error { return sodogo.WriteSDK(w, sodogo.Puzzle{Board: b}) },
+	"ss":      sodogo.WriteSS,
+	"json": func(w io.Writer, b *sodogo.Board) error {
+		data, err := b.MarshalJSON()
+		if err != nil {
+			return err
+		}
+		return writeString(w, string(data)+"\n")
+	},
+	"svg": func(w io.Writer, b *sodogo.Board) error { return render.SVG(w, b, render.Options{}) },
+	"png": func(w io.Writer, b *sodogo.Board) error { return render.PNG(w, b, render.Options{}) },
+}
+
+// command a subcommand run
+type command struct {
+	size   int
+	format string
+	count  int
+	seed   int64
+	server serverFlags
+	args   []string
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+}
+
+// input a loaded puzzle, err when it is malformed
+type input struct {
+	board *sodogo.Board
+	err   error
+}
+
+// commands subcommands by name
+var commands = map[string]func(c *command) int{
+	"solve":    (*command).solve,
+	"validate": (*command).validate,
+	"generate": (*command).generate,
+	"grade":    (*command).grade,
+	"hint":     (*command).hint,
+	"convert":  (*command).convert,
+	"play":     (*command).play,
+	"serve":    (*command).serve,
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run runs a command line and returns the exit status
+func run(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, usage)
+		return exitUsage
+	}
+	name := args[0]
+	if name == "help" || name == "-h" || name == "--help" {
+		fmt.Fprint(stdout, usage)
+		return exitSolved
+	}
+	cmd, ok := commands[name]
+	if !ok {
+		fmt.Fprintf(stderr, "Unknown command %q\n\n%s", name, usage)
+		return exitUsage
+	}
+
+	c := &command{stdin: stdin, stdout: stdout, stderr: stderr}
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() { fmt.Fprint(stderr, usage) }
+	fs.IntVar(&c.size, "size", 3, "flat size")
+	fs.StringVar(&c.format, "format", "line", "output format")
+	fs.IntVar(&c.count, "count", 1, "generated puzzles")
+	fs.Int64Var(&c.seed, "seed", 0, "random seed")
+	fs.StringVar(&c.server.addr, "addr", ":8080", "listen address")
+	fs.DurationVar(&c.server.timeout, "timeout", 10*time.Second, "request timeout")
+	fs.IntVar(&c.server.maxConcurrent, "max-concurrent", 0, "requests solving at the same time")
+	if err := parseInterspersed(fs, args[1:], &c.args); err != nil {
+		return exitUsage
+	}
+	if c.size < 2 || c.size > 7 {
+		fmt.Fprintf(stderr, "Invalid size %d, valid sizes are 2 to 7\n", c.size)
+		return exitUsage
+	}
+	if _, ok := formats[c.format]; !ok {
+		fmt.Fprintf(stderr, "Unknown format %q\n", c.format)
+		return exitUsage
+	}
+	return cmd(c)
+}
+
+// parseInterspersed parses the flags before and after the positional arguments
+func parseInterspersed(fs *flag.FlagSet, args []string, positional *[]string) error {
+	for {
+		if err := fs.Parse(args); err != nil {
+			return err
+		}
+		if fs.NArg() == 0 {
+			return nil
+		}
+		*positional = append(*positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+}
+
+func (c *command) solve() (status int) {
+	return c.eachInput(func(b *sodogo.Board) int {
+		status := exitSolved
+		if !b.Solve() {
+			fmt.Fprintln(c.stderr, "unsolvable")
+			status = exitUnsolvable
+		}
+		return maxStatus(status, c.write(b))
+	})
+}
+
+func (c *command) validate() int {
+	return c.eachInput(func(b *sodogo.Board) int {
+		if err := b.Validate(); err != nil {
+			fmt.Fprintf(c.stdout, "invalid: %v\n", err)
+			return exitInvalid
+		}
+		fmt.Fprintln(c.stdout, "valid")
+		return exitSolved
+	})
+}
+
+func (c *command) generate() (status int) {
+	if !c.checkGenerateSize() {
+		return exitUsage
+	}
+	seed := c.seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	h, err := sodogo.NewCheckedHelperBoard(c.size)
+	if err != nil {
+		fmt.Fprintln(c.stderr, err)
+		return exitUsage
+	}
+	rng := rand.New(rand.NewSource(seed))
+	for num := 0; num < c.count; num++ {
+		b, err := sodogo.Generate(h, rng)
+		if err != nil {
+			fmt.Fprintln(c.stderr, err)
+			return exitUsage
+		}
+		status = maxStatus(status, c.write(b))
+	}
+	return status
+}
+
+// checkGenerateSize returns if the size can be generated, writing the error
+// when it can not
+func (c *command) checkGenerateSize() bool {
+	if c.size > maxGenerateSize {
+		fmt.Fprintf(c.stderr, "Invalid size %d, valid sizes to generate are 2 to %d\n", c.size, maxGenerateSize)
+		return false
+	}
+	return true
+}
+
+func (c *command) grade() int {
+	return c.eachInput(func(b *sodogo.Board) int {
+		grade := b.Grade()
+		fmt.Fprintln(c.stdout, grade)
+		if grade == sodogo.GradeUnsolvable {
+			return exitUnsolvable
+		}
+		return exitSolved
+	})
+}
+
+func (c *command) hint() int {
+	return c.eachInput(func(b *sodogo.Board) int {
+		pos, value, ok := b.Hint()
+		if !ok {
+			if b.Solve() {
+				fmt.Fprintln(c.stdout, "solved")
+				return exitSolved
+			}
+			fmt.Fprintln(c.stderr, "no hint")
+			return exitUnsolvable
+		}
+		fmt.Fprintf(c.stdout, "%v %d\n", pos, value)
+		return exitSolved
+	})
+}
+
+func (c *command) convert() int {
+	return c.eachInput(c.write)
+}
+
+// eachInput runs a function on every input puzzle, returns the worst status
+func (c *command) eachInput(f func(b *sodogo.Board) int) (status int) {
+	inputs, err := c.readInputs()
+	if err != nil {
+		fmt.Fprintln(c.stderr, err)
+		return exitUsage
+	}
+	for num := range inputs {
+		if inputs[num].err != nil {
+			fmt.Fprintf(c.stderr, "invalid: %v\n", inputs[num].err)
+			status = maxStatus(status, exitInvalid)
+			continue
+		}
+		status = maxStatus(status, f(inputs[num].board))
+	}
+	return status
+}
+
+// readInputs loads the puzzles of the arguments, an existing file or a
+// puzzle text, or stdin without arguments
+func (c *command) readInputs() (inputs []input, err error) {
+	h, err := sodogo.NewCheckedHelperBoard(c.size)
+	if err != nil {
+		return nil, err
+	}
+	if len(c.args) == 0 {
+		text, err := io.ReadAll(c.stdin)
+		if err != nil {
+			return nil, err
+		}
+		return parseInputs(string(text), h), nil
+	}
+	for _, arg := range c.args {
+		text := arg
+		if info, err := os.Stat(arg); err == nil && info.Mode().IsRegular() {
+			data, err := os.ReadFile(arg)
+			if err != nil {
+				return nil, err
+			}
+			text = string(data)
+		}
+		inputs = append(inputs, parseInputs(text, h)...)
+	}
+	return inputs, nil
+}
+
+// parseInputs loads the puzzles of a text: a SadMan .sdk file, a puzzle per
+// line or a single text grid
+func parseInputs(text string, h sodogo.HelperBoard) (inputs []input) {
+	if strings.HasPrefix(strings.TrimSpace(text), "#") {
+		p, err := sodogo.ReadSDK(strings.NewReader(text), h)
+		return []input{{p.Board, err}}
+	}
+	lines := []string{}
+	for _, line := range strings.Split(text, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	empty := sodogo.NewBoard(h)
+	perLine := len(lines) > 0
+	for _, line := range lines {
+		perLine = perLine && len(line) == len(empty.String())
+	}
+	if !perLine {
+		lines = []string{text}
+	}
+	for _, line := range lines {
+		b := sodogo.NewBoard(h)
+		inputs = append(inputs, input{b, b.LoadFromText(line)})
+	}
+	return inputs
+}
+
+// write writes a board in the output format
+func (c *command) write(b *sodogo.Board) int {
+	if err := formats[c.format](c.stdout, b); err != nil {
+		fmt.Fprintln(c.stderr, err)
+		return exitUsage
+	}
+	return exitSolved
+}
+
+// printStyle returns a NicePrint output format
+func printStyle(opts sodogo.PrintOptions) func(w io.Writer, b *sodogo.Board) error {
+	return func(w io.Writer, b *sodogo.Board) error {
+		return writeString(w, b.NicePrintWith(opts))
+	}
+}
+
+// writeString writes a string
+func writeString(w io.Writer, s string) error {
+	_, err := io.WriteString(w, s)
+	return err
+}
+
+// maxStatus returns the worst exit status
+func maxStatus(a int, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,196 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const (
+	testPuzzle     = "004300209005009001070060043006002087190007400050083000600000105003508690042910300"
+	testSolution   = "864371259325849761971265843436192587198657432257483916689734125713528694542916378"
+	testImpossible = "800000000003600000070090200050007000000045700000100030001000068008500010090000400"
+)
+
+func TestRun(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "puzzle.sdk")
+	if err := os.WriteFile(file, []byte("#Aauthor\n1.3.\n3..2\n.1.3\n4.2.\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		args       []string
+		stdin      string
+		wantStatus int
+		wantOut    string
+		wantErr    string
+	}{
+		{
+			name:    "solve argument",
+			args:    []string{"solve", testPuzzle},
+			wantOut: testSolution + "\n",
+		},
+		{
+			name:    "solve stdin, a puzzle per line",
+			args:    []string{"solve"},
+			stdin:   testPuzzle + "\n\n" + testPuzzle + "\n",
+			wantOut: testSolution + "\n" + testSolution + "\n",
+		},
+		{
+			name:    "solve file and flags after the arguments",
+			args:    []string{"solve", file, "--size", "2"},
+			wantOut: "1234341221434321\n",
+		},
+		{
+			name:       "solve unsolvable",
+			args:       []string{"solve", testImpossible},
+			wantStatus: exitUnsolvable,
+			wantOut:    testImpossible + "\n",
+			wantErr:    "unsolvable\n",
+		},
+		{
+			name:       "solve invalid",
+			args:       []string{"solve", "--size=2", "1100000000000000", "1.3.3..2.1.34.2."},
+			wantStatus: exitInvalid,
+			wantOut:    "1234341221434321\n",
+			wantErr:    "invalid: Given conflicts with position 0 '1' at position 1 (row 0, column 1)\n",
+		},
+		{
+			name:    "solve text grid",
+			args:    []string{"solve", "--size", "2"},
+			stdin:   "1 . | 3 .\n3 . | . 2\n----+----\n. 1 | . 3\n4 . | 2 .\n",
+			wantOut: "1234341221434321\n",
+		},
+		{
+			name:    "validate",
+			args:    []string{"validate", testPuzzle},
+			wantOut: "valid\n",
+		},
+		{
+			name:       "validate no candidates",
+			args:       []string{"validate", "1200000300000004", "--size", "2"},
+			wantStatus: exitInvalid,
+			wantOut:    "invalid: Cell r1c4 has no candidates\n",
+		},
+		{
+			name:       "validate malformed",
+			args:       []string{"validate", "123"},
+			wantStatus: exitInvalid,
+			wantErr:    "invalid: A valid board text contains 81 cells, not 3\n",
+		},
+		{
+			name:    "grade",
+			args:    []string{"grade", testPuzzle},
+			wantOut: "easy\n",
+		},
+		{
+			name:       "grade unsolvable",
+			args:       []string{"grade", testImpossible},
+			wantStatus: exitUnsolvable,
+			wantOut:    "unsolvable\n",
+		},
+		{
+			name:    "hint",
+			args:    []string{"hint", testPuzzle},
+			wantOut: "r1c1 8\n",
+		},
+		{
+			name:    "hint solved",
+			args:    []string{"hint", testSolution},
+			wantOut: "solved\n",
+		},
+		{
+			name:       "hint unsolvable",
+			args:       []string{"hint", testImpossible},
+			wantStatus: exitUnsolvable,
+			wantErr:    "no hint\n",
+		},
+		{
+			name:    "convert",
+			args:    []string{"convert", "--size", "2", "--format", "ss", "1000002100000000"},
+			wantOut: "1.|..\n..|21\n-----\n..|..\n..|..\n",
+		},
+		{
+			name:    "convert compact",
+			args:    []string{"convert", "--size", "2", "--format", "compact", "1000002100000000"},
+			wantOut: "1 .  . .\n. .  2 1\n\n. .  . .\n. .  . .\n",
+		},
+		{
+			name:    "convert json",
+			args:    []string{"convert", "--size", "2", "--format", "json", "1000000000000000"},
+			wantOut: `{"size":2,"givens":"1000000000000000","values":"1000000000000000"}` + "\n",
+		},
+		{
+			name:       "unknown command",
+			args:       []string{"dance"},
+			wantStatus: exitUsage,
+			wantErr:    "Unknown command \"dance\"\n\n" + usage,
+		},
+		{
+			name:       "unknown format",
+			args:       []string{"convert", "--format", "pdf"},
+			wantStatus: exitUsage,
+			wantErr:    "Unknown format \"pdf\"\n",
+		},
+		{
+			name:       "invalid size",
+			args:       []string{"solve", "--size", "1"},
+			wantStatus: exitUsage,
+			wantErr:    "Invalid size 1, valid sizes are 2 to 7\n",
+		},
+		{
+			name:       "generate size",
+			args:       []string{"generate", "--size", "5"},
+			wantStatus: exitUsage,
+			wantErr:    "Invalid size 5, valid sizes to generate are 2 to 4\n",
+		},
+		{
+			name:       "no command",
+			wantStatus: exitUsage,
+			wantErr:    usage,
+		},
+		{
+			name:    "help",
+			args:    []string{"help"},
+			wantOut: usage,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			status := run(tt.args, strings.NewReader(tt.stdin), &stdout, &stderr)
+			if status != tt.wantStatus {
+				t.Errorf("run() status = %v, want %v", status, tt.wantStatus)
+			}
+			if res := stdout.String(); res != tt.wantOut {
+				t.Errorf("run() stdout = %q, want %q", res, tt.wantOut)
+			}
+			if res := stderr.String(); res != tt.wantErr {
+				t.Errorf("run() stderr = %q, want %q", res, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRun_generate(t *testing.T) {
+	var first, second, stderr bytes.Buffer
+	if status := run([]string{"generate", "--size", "2", "--count", "3", "--seed", "5"}, nil, &first, &stderr); status != exitSolved {
+		t.Fatalf("run() status = %v, stderr %v", status, stderr.String())
+	}
+	_ = run([]string{"generate", "--size", "2", "--count", "3", "--seed", "5"}, nil, &second, &stderr)
+	if first.String() != second.String() {
+		t.Errorf("run() res = %v, want %v", second.String(), first.String())
+	}
+
+	lines := strings.Split(strings.TrimSuffix(first.String(), "\n"), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("run() puzzles = %v, want 3", len(lines))
+	}
+	var out bytes.Buffer
+	if status := run(append([]string{"solve", "--size", "2"}, lines...), nil, &out, &stderr); status != exitSolved {
+		t.Errorf("run() solve status = %v, want %v", status, exitSolved)
+	}
+}
@@ -0,0 +1,329 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rfiestas/sodogo"
+	"golang.org/x/term"
+)
+
+const playHelp = `Commands, a line each when the input is not a terminal:
+  w a s d      move the cursor up, left, down or right, repeat to move more: ddd
+  g ROW COL    move the cursor to a cell, rows and columns from 1
+  SYMBOL       enter a value in the cursor cell
+  x            clear the cursor cell
+  p SYMBOLS    toggle pencil marks of the cursor cell: p 137
+  m            list the pencil marks
+  u            undo
+  r            redo
+  h            show a hint and move the cursor to its cell
+  c            list the wrong cells
+  k            switch between checking the conflicts and the solution
+  ?            show this help
+  q            quit
+The values are checked first, on 6x6 boards a is the value 36, not a move.
+`
+
+// now the game clock, replaced by the tests
+var now = time.Now
+
+// game a game in progress
+type game struct {
+	*sodogo.Game
+	size     int
+	alphabet string
+	cursor   sodogo.Position
+	pencil   bool // the keys toggle pencil marks instead of entering values
+	opts     sodogo.PrintOptions
+	out      io.Writer
+}
+
+func (c *command) play() int {
+	if c.size > 6 {
+		fmt.Fprintf(c.stderr, "Invalid size %d, valid sizes to play are 2 to 6\n", c.size)
+		return exitUsage
+	}
+	opts, ok := map[string]sodogo.PrintOptions{
+		"line":   {},
+		"pretty": {},
+		"ascii":  {Style: sodogo.ASCIIStyle},
+		"color":  {Colors: true},
+	}[c.format]
+	if !ok {
+		fmt.Fprintf(c.stderr, "Invalid format %q, valid formats to play are pretty, ascii and color\n", c.format)
+		return exitUsage
+	}
+	b, status := c.playBoard()
+	if b == nil {
+		return status
+	}
+
+	g := newGame(b, c.size, opts, c.stdout)
+	if f, ok := c.stdin.(*os.File); ok && term.IsTerminal(int(f.Fd())) {
+		// the keys of a terminal, the conflicts are highlighted as they happen
+		g.opts.Colors = true
+		if err := c.playTerminal(g, f); err != nil {
+			fmt.Fprintln(c.stderr, err)
+			return exitUsage
+		}
+	} else {
+		g.print()
+		scanner := bufio.NewScanner(c.stdin)
+		for fmt.Fprint(c.stdout, "> "); scanner.Scan(); fmt.Fprint(c.stdout, "> ") {
+			if g.command(strings.TrimSpace(scanner.Text())) {
+				break
+			}
+		}
+		fmt.Fprintln(c.stdout)
+	}
+	if g.IsSolved() {
+		return exitSolved
+	}
+	return exitUnsolvable
+}
+
+// playBoard returns the puzzle of the first argument, or a generated puzzle
+// without arguments, stdin is used for the commands
+func (c *command) playBoard() (*sodogo.Board, int) {
+	if len(c.args) == 0 {
+		if !c.checkGenerateSize() {
+			return nil, exitUsage
+		}
+		seed := c.seed
+		if seed == 0 {
+			seed = time.Now().UnixNano()
+		}
+		h, err := sodogo.NewCheckedHelperBoard(c.size)
+		if err != nil {
+			fmt.Fprintln(c.stderr, err)
+			return nil, exitUsage
+		}
+		b, err := sodogo.Generate(h, rand.New(rand.NewSource(seed)))
+		if err != nil {
+			fmt.Fprintln(c.stderr, err)
+			return nil, exitUsage
+		}
+		return b, exitSolved
+	}
+	inputs, err := c.readInputs()
+	if err != nil {
+		fmt.Fprintln(c.stderr, err)
+		return nil, exitUsage
+	}
+	if len(inputs) == 0 {
+		fmt.Fprintln(c.stderr, "No puzzle to play")
+		return nil, exitUsage
+	}
+	if inputs[0].err != nil {
+		fmt.Fprintf(c.stderr, "invalid: %v\n", inputs[0].err)
+		return nil, exitInvalid
+	}
+	return inputs[0].board, exitSolved
+}
+
+// newGame returns a game of a puzzle, its filled values are the givens
+func newGame(b *sodogo.Board, size int, opts sodogo.PrintOptions, out io.Writer) *game {
+	h := sodogo.NewHelperBoard(size)
+	g := &game{
+		Game:     sodogo.NewGame(b),
+		size:     size,
+		alphabet: h.Alphabet(),
+		opts:     opts,
+		out:      out,
+	}
+	g.SetClock(now)
+	return g
+}
+
+// command runs a player command line, returns if the game ends. The symbols
+// are values before anything else.
+func (g *game) command(line string) (done bool) {
+	fields := strings.Fields(line)
+	var err error
+	switch {
+	case line == "":
+		return false
+	case len(line) == 1 && strings.Contains(g.alphabet, line):
+		err = g.Set(g.cursor, strings.Index(g.alphabet, line)+1)
+	case line == "q":
+		return true
+	case line == "?":
+		fmt.Fprint(g.out, playHelp)
+		return false
+	case line == "u":
+		if !g.Undo() {
+			fmt.Fprintln(g.out, "Nothing to undo")
+		}
+	case line == "r":
+		if !g.Redo() {
+			fmt.Fprintln(g.out, "Nothing to redo")
+		}
+	case line == "h":
+		g.hint()
+	case line == "c":
+		g.check()
+		return false
+	case line == "k":
+		g.toggleStrict()
+		return false
+	case line == "m":
+		g.printMarks()
+		return false
+	case line == "x":
+		err = g.Clear(g.cursor)
+	case strings.Trim(line, "wasd") == "":
+		g.move(line)
+	case fields[0] == "g" && len(fields) == 3:
+		g.jump(fields[1], fields[2])
+	case fields[0] == "p" && len(fields) == 2:
+		err = g.toggleMarks(fields[1])
+	default:
+		fmt.Fprintf(g.out, "Unknown command %q, ? shows the help\n", line)
+		return false
+	}
+	if err != nil {
+		fmt.Fprintln(g.out, err)
+	}
+	g.print()
+	if g.IsSolved() {
+		fmt.Fprintf(g.out, "Solved in %v\n", g.elapsed())
+		return true
+	}
+	return false
+}
+
+// toggleMarks toggles the pencil marks of the cursor cell
+func (g *game) toggleMarks(symbols string) error {
+	for _, symbol := range symbols {
+		if !strings.ContainsRune(g.alphabet, symbol) {
+			return fmt.Errorf("Invalid symbol %q", symbol)
+		}
+	}
+	for _, symbol := range symbols {
+		if err := g.ToggleMark(g.cursor, strings.IndexRune(g.alphabet, symbol)+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// move moves the cursor a cell per w, a, s or d, wrapping around the board
+func (g *game) move(moves string) {
+	maxValue := g.size * g.size
+	for _, move := range moves {
+		switch move {
+		case 'w':
+			g.cursor.Row = (g.cursor.Row + maxValue - 1) % maxValue
+		case 's':
+			g.cursor.Row = (g.cursor.Row + 1) % maxValue
+		case 'a':
+			g.cursor.Col = (g.cursor.Col + maxValue - 1) % maxValue
+		case 'd':
+			g.cursor.Col = (g.cursor.Col + 1) % maxValue
+		}
+	}
+}
+
+// jump moves the cursor to a row and column, from 1
+func (g *game) jump(row string, col string) {
+	maxValue := g.size * g.size
+	r, errRow := strconv.Atoi(row)
+	c, errCol := strconv.Atoi(col)
+	if errRow != nil || errCol != nil || r < 1 || r > maxValue || c < 1 || c > maxValue {
+		fmt.Fprintf(g.out, "Invalid cell %s %s, rows and columns are 1 to %d\n", row, col, maxValue)
+		return
+	}
+	g.cursor = sodogo.Position{Row: r - 1, Col: c - 1}
+}
+
+// hint shows the next cell the solver fills and moves the cursor to it
+func (g *game) hint() {
+	b := g.Board()
+	p, value, ok := b.Hint()
+	if !ok {
+		fmt.Fprintln(g.out, "No hint, check the conflicts")
+		return
+	}
+	g.cursor = p
+	fmt.Fprintf(g.out, "Hint: %v %c\n", p, g.alphabet[value-1])
+}
+
+// check lists the wrong cells
+func (g *game) check() {
+	cells := []string{}
+	for _, p := range g.Conflicts().Cells {
+		cells = append(cells, p.String())
+	}
+	if len(cells) == 0 {
+		fmt.Fprintln(g.out, "No wrong cells")
+		return
+	}
+	fmt.Fprintf(g.out, "Wrong cells: %s\n", strings.Join(cells, " "))
+}
+
+// toggleStrict switches between checking the conflicts and checking
+// against the solution
+func (g *game) toggleStrict() {
+	if g.CheckMode() == sodogo.CheckStrict {
+		_ = g.SetCheckMode(sodogo.CheckConflicts)
+		fmt.Fprintln(g.out, "Checking the conflicts")
+		return
+	}
+	if err := g.SetCheckMode(sodogo.CheckStrict); err != nil {
+		fmt.Fprintln(g.out, err)
+		return
+	}
+	fmt.Fprintln(g.out, "Checking against the solution")
+}
+
+// print prints the board with the cursor and the status line
+func (g *game) print() {
+	b := g.Board()
+	opts := g.opts
+	opts.Cursor = &g.cursor
+	fmt.Fprint(g.out, b.NicePrintWith(opts))
+	status := []string{g.cursor.String(), g.elapsed().String()}
+	if marks := g.symbols(g.Marks(g.cursor)); marks != "" {
+		status = append(status, "marks "+marks)
+	}
+	if wrong := len(g.Conflicts().Cells); wrong > 0 {
+		status = append(status, fmt.Sprintf("%d wrong", wrong))
+	}
+	fmt.Fprintln(g.out, strings.Join(status, "  "))
+}
+
+// printMarks lists the empty cells with pencil marks
+func (g *game) printMarks() {
+	maxValue := g.size * g.size
+	b := g.Board()
+	for row := 0; row < maxValue; row++ {
+		for col := 0; col < maxValue; col++ {
+			p := sodogo.Position{Row: row, Col: col}
+			value, _ := b.Get(row, col)
+			if marks := g.symbols(g.Marks(p)); value == 0 && marks != "" {
+				fmt.Fprintf(g.out, "%v %s\n", p, marks)
+			}
+		}
+	}
+}
+
+// symbols returns the symbols of some values
+func (g *game) symbols(values []int) string {
+	var buffer strings.Builder
+	for _, value := range values {
+		buffer.WriteByte(g.alphabet[value-1])
+	}
+	return buffer.String()
+}
+
+// elapsed returns the game playing time, in seconds
+func (g *game) elapsed() time.Duration {
+	return g.Elapsed().Round(time.Second)
+}
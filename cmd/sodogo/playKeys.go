@@ -0,0 +1,226 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"golang.org/x/term"
+)
+
+const keysHelp = `Keys:
+  arrows       move the cursor
+  SYMBOL       enter a value in the cursor cell, or toggle a pencil mark
+  backspace    clear the cursor cell, also delete, space, 0 and .
+  /            switch between entering values and pencil marks
+  < or ctrl-z  undo
+  > or ctrl-y  redo
+  !            show a hint and move the cursor to its cell
+  =            switch between checking the conflicts and the solution
+  ?            show this help
+  q or ctrl-c  quit
+The values are checked first, a symbol of the board is never a command.
+`
+
+// key a key press, the caracter of the printable keys
+type key string
+
+// Special keys
+const (
+	keyUp        key = "up"
+	keyDown      key = "down"
+	keyLeft      key = "left"
+	keyRight     key = "right"
+	keyBackspace key = "backspace"
+	keyDelete    key = "delete"
+	keyUndo      key = "ctrl-z"
+	keyRedo      key = "ctrl-y"
+	keyQuit      key = "ctrl-c"
+	keyOther     key = "other"
+)
+
+// clearScreen moves the terminal cursor home and clears the screen
+const clearScreen = "\x1b[H\x1b[2J"
+
+// readKey reads a key press, arrows and delete are escape sequences
+func readKey(r *bufio.Reader) (key, error) {
+	c, err := r.ReadByte()
+	if err != nil {
+		return "", err
+	}
+	switch {
+	case c == 0x1b:
+		return readEscape(r)
+	case c == 0x7f || c == 0x08:
+		return keyBackspace, nil
+	case c == 0x1a:
+		return keyUndo, nil
+	case c == 0x19:
+		return keyRedo, nil
+	case c == 0x03 || c == 0x04:
+		return keyQuit, nil
+	case c > ' ' && c <= '~' || c == ' ':
+		return key(c), nil
+	}
+	return keyOther, nil
+}
+
+// readEscape reads the rest of an escape sequence, a lone escape is not a
+// command
+func readEscape(r *bufio.Reader) (key, error) {
+	if r.Buffered() == 0 {
+		return keyOther, nil
+	}
+	c, err := r.ReadByte()
+	if err != nil || (c != '[' && c != 'O') {
+		return keyOther, err
+	}
+	sequence := []byte{}
+	for {
+		c, err := r.ReadByte()
+		if err != nil {
+			return keyOther, err
+		}
+		sequence = append(sequence, c)
+		if c >= 0x40 && c <= 0x7e {
+			break
+		}
+	}
+	k, ok := map[string]key{"A": keyUp, "B": keyDown, "C": keyRight, "D": keyLeft, "3~": keyDelete}[string(sequence)]
+	if !ok {
+		return keyOther, nil
+	}
+	return k, nil
+}
+
+// playTerminal plays with the keys of a terminal in raw mode, redrawing the
+// board on every key and every second for the timer
+func (c *command) playTerminal(g *game, stdin *os.File) error {
+	state, err := term.MakeRaw(int(stdin.Fd()))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = term.Restore(int(stdin.Fd()), state) }()
+
+	keys := make(chan key)
+	go func() {
+		defer close(keys)
+		r := bufio.NewReader(stdin)
+		for {
+			k, err := readKey(r)
+			if err != nil {
+				return
+			}
+			keys <- k
+		}
+	}()
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	g.playKeys(keys, ticker.C, crlfWriter{c.stdout})
+	return nil
+}
+
+// playKeys runs the keys until the game is solved, quit or the keys end
+func (g *game) playKeys(keys <-chan key, ticks <-chan time.Time, screen io.Writer) {
+	out := g.out
+	defer func() { g.out = out }()
+	var message bytes.Buffer
+	g.draw(screen, "")
+	for {
+		select {
+		case k, ok := <-keys:
+			if !ok {
+				return
+			}
+			message.Reset()
+			g.out = &message
+			done := g.key(k)
+			g.draw(screen, message.String())
+			if done {
+				return
+			}
+		case <-ticks:
+			g.draw(screen, message.String())
+		}
+	}
+}
+
+// key runs a key press, returns if the game ends. The symbols are values
+// before anything else.
+func (g *game) key(k key) (done bool) {
+	var err error
+	if len(k) == 1 && strings.Contains(g.alphabet, string(k)) {
+		if g.pencil {
+			err = g.toggleMarks(string(k))
+		} else {
+			err = g.Set(g.cursor, strings.Index(g.alphabet, string(k))+1)
+		}
+	} else {
+		switch k {
+		case keyUp, keyDown, keyLeft, keyRight:
+			g.move(map[key]string{keyUp: "w", keyLeft: "a", keyDown: "s", keyRight: "d"}[k])
+		case keyBackspace, keyDelete, " ", "0", ".":
+			err = g.Clear(g.cursor)
+		case keyUndo, "<":
+			if !g.Undo() {
+				fmt.Fprintln(g.out, "Nothing to undo")
+			}
+		case keyRedo, ">":
+			if !g.Redo() {
+				fmt.Fprintln(g.out, "Nothing to redo")
+			}
+		case "/":
+			g.pencil = !g.pencil
+		case "!":
+			g.hint()
+		case "=":
+			g.toggleStrict()
+		case "?":
+			fmt.Fprint(g.out, keysHelp)
+		case "q", keyQuit:
+			return true
+		default:
+			fmt.Fprintf(g.out, "Unknown key %q, ? shows the keys\n", string(k))
+		}
+	}
+	if err != nil {
+		fmt.Fprintln(g.out, err)
+	}
+	if g.IsSolved() {
+		fmt.Fprintf(g.out, "Solved in %v\n", g.elapsed())
+		return true
+	}
+	return false
+}
+
+// draw clears the screen and prints the board, its status line, the entry
+// mode and a message
+func (g *game) draw(screen io.Writer, message string) {
+	out := g.out
+	defer func() { g.out = out }()
+	g.out = screen
+	fmt.Fprint(screen, clearScreen)
+	g.print()
+	mode := "values"
+	if g.pencil {
+		mode = "pencil marks"
+	}
+	fmt.Fprintf(screen, "Entering %s, ? shows the keys, q quits\n%s", mode, message)
+}
+
+// crlfWriter writes the new lines as carriage returns and new lines, a
+// terminal in raw mode does not return the carriage
+type crlfWriter struct {
+	w io.Writer
+}
+
+func (c crlfWriter) Write(p []byte) (int, error) {
+	if _, err := c.w.Write(bytes.ReplaceAll(p, []byte("\n"), []byte("\r\n"))); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
@@ -0,0 +1,147 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rfiestas/sodogo"
+)
+
+func TestReadKey(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []key
+	}{
+		{
+			name:  "arrows",
+			input: "\x1b[A\x1b[B\x1b[C\x1b[D\x1bOA",
+			want:  []key{keyUp, keyDown, keyRight, keyLeft, keyUp},
+		},
+		{
+			name:  "symbols and commands",
+			input: "1a ?q",
+			want:  []key{"1", "a", " ", "?", "q"},
+		},
+		{
+			name:  "control keys",
+			input: "\x7f\x1b[3~\x1a\x19\x03",
+			want:  []key{keyBackspace, keyDelete, keyUndo, keyRedo, keyQuit},
+		},
+		{
+			name:  "unknown sequences",
+			input: "\x1b[15~\x1bx\t",
+			want:  []key{keyOther, keyOther, keyOther},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := bufio.NewReader(strings.NewReader(tt.input))
+			res := []key{}
+			for {
+				k, err := readKey(r)
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					t.Fatalf("readKey() error = %v", err)
+				}
+				res = append(res, k)
+			}
+			if !reflect.DeepEqual(res, tt.want) {
+				t.Errorf("readKey() = %q, want %q", res, tt.want)
+			}
+		})
+	}
+}
+
+func TestGame_playKeys(t *testing.T) {
+	tests := []struct {
+		name     string
+		size     int
+		puzzle   string
+		keys     []key
+		wantDone bool
+		wantOut  []string
+	}{
+		{
+			name:     "solve",
+			size:     2,
+			puzzle:   testPlayPuzzle,
+			keys:     []key{keyRight, "2", keyRight, keyRight, "4", keyDown, keyLeft, keyLeft, "4", keyRight, "1", keyDown, keyLeft, keyLeft, "2", keyRight, keyRight, "4", keyDown, keyLeft, "3", keyRight, keyRight, "1"},
+			wantDone: true,
+			wantOut:  []string{clearScreen, "|>\x1b[34m1\x1b[0m<|\n+===+===+===+===+\nr4c4  ", "Solved in "},
+		},
+		{
+			name:    "conflicts are highlighted as they happen",
+			size:    2,
+			puzzle:  testPlayPuzzle,
+			keys:    []key{"2", keyRight, "1"},
+			wantOut: []string{"Cell r1c1 is a given\n", "|>\x1b[1;31m1\x1b[0m<|", "  3 wrong\n"},
+		},
+		{
+			name:    "pencil marks, undo and redo",
+			size:    2,
+			puzzle:  testPlayPuzzle,
+			keys:    []key{keyRight, "/", "4", "1", "/", keyUndo, "<", ">", "<", "<", "<"},
+			wantOut: []string{"Entering pencil marks", "r1c2  50s  marks 14\n", "r1c2  1m10s  marks 4\n", "Nothing to undo\n"},
+		},
+		{
+			name:    "hints, help and unknown keys",
+			size:    2,
+			puzzle:  testPlayPuzzle,
+			keys:    []key{"!", "?", "x", keyOther},
+			wantOut: []string{"Hint: r1c4 4\n", keysHelp, "Unknown key \"x\", ? shows the keys\n", "Unknown key \"other\""},
+		},
+		{
+			name:    "a symbol is a value before a command",
+			size:    6,
+			puzzle:  strings.Repeat("0", 36*36),
+			keys:    []key{"a"},
+			wantOut: []string{">\x1b[34ma\x1b[0m<"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+			now = func() time.Time {
+				clock = clock.Add(10 * time.Second)
+				return clock
+			}
+			defer func() { now = time.Now }()
+			b := sodogo.NewBoard(sodogo.NewHelperBoard(tt.size))
+			if err := b.LoadFromString(tt.puzzle); err != nil {
+				t.Fatal(err)
+			}
+			var out bytes.Buffer
+			g := newGame(b, tt.size, sodogo.PrintOptions{Style: sodogo.ASCIIStyle, Colors: true}, &out)
+			keys := make(chan key, len(tt.keys))
+			for _, k := range tt.keys {
+				keys <- k
+			}
+			close(keys)
+			g.playKeys(keys, nil, &out)
+
+			if g.IsSolved() != tt.wantDone {
+				t.Errorf("playKeys() solved = %v, want %v", g.IsSolved(), tt.wantDone)
+			}
+			for _, want := range tt.wantOut {
+				if !strings.Contains(out.String(), want) {
+					t.Errorf("playKeys() out = %q, want %q", out.String(), want)
+				}
+			}
+		})
+	}
+}
+
+func TestCrlfWriter(t *testing.T) {
+	var out bytes.Buffer
+	if n, err := (crlfWriter{&out}).Write([]byte("a\nb\n")); n != 4 || err != nil || out.String() != "a\r\nb\r\n" {
+		t.Errorf("crlfWriter.Write() = %v %v %q, want 4 a\\r\\nb\\r\\n", n, err, out.String())
+	}
+}
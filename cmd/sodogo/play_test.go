@@ -0,0 +1,124 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testPlayPuzzle = "1.3.3..2.1.34.2."
+
+func TestRun_play(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name       string
+		args       []string
+		stdin      string
+		wantStatus int
+		wantOut    []string
+		wantErr    string
+	}{
+		{
+			name:    "solve",
+			args:    []string{"play", "--size", "2", "--format", "ascii", testPlayPuzzle},
+			stdin:   "d\n2\ndd\n4\ng 2 2\n4\nd\n1\ng 3 1\n2\ng 3 3\n4\ng 4 2\n3\ng 4 4\n1\nq\n",
+			wantOut: []string{"+===+===+===+===+\n| 1 |> <| 3 |   |\n", "| 4 | 3 | 2 |>1<|\n+===+===+===+===+\nr4c4  ", "Solved in "},
+		},
+		{
+			name:       "givens, conflicts and unknown commands",
+			args:       []string{"play", "--size", "2", testPlayPuzzle},
+			stdin:      "1\nd\n1\nc\nx\nc\nz\n",
+			wantStatus: exitUnsolvable,
+			wantOut:    []string{"Cell r1c1 is a given\n", "║ 1 │>1<║ 3 │   ║\n", "r1c2  40s  3 wrong\n", "Wrong cells: r1c1 r1c2 r3c2\n", "No wrong cells\n", "Unknown command \"z\", ? shows the help\n"},
+		},
+		{
+			name:       "undo and redo",
+			args:       []string{"play", "--size", "2", "--format", "ascii", testPlayPuzzle},
+			stdin:      "d\n2\ng 2 2\n4\nu\nu\nu\nr\n",
+			wantStatus: exitUnsolvable,
+			wantOut:    []string{"| 3 |> <|   | 2 |\n", "Nothing to undo\n", "| 1 | 2 | 3 |   |\n+---+---+---+---+\n| 3 |> <|   | 2 |\n+===+===+===+===+\n|   | 1 |   | 3 |\n+---+---+---+---+\n| 4 |   | 2 |   |\n+===+===+===+===+\nr2c2  1m30s\n"},
+		},
+		{
+			name:       "pencil marks and hints",
+			args:       []string{"play", "--size", "2", "--format", "ascii", testPlayPuzzle},
+			stdin:      "s\np 1\nd\np 41\np 1\nd\np 21\nm\nh\n?\n",
+			wantStatus: exitUnsolvable,
+			wantOut:    []string{"Cell r2c1 is a given\n", "r2c2  50s  marks 14\n", "r2c2  1m0s  marks 4\n", "> r2c2 4\nr2c3 12\n> ", "Hint: r1c4 4\n", "Hint: ", playHelp},
+		},
+		{
+			name:       "strict checking",
+			args:       []string{"play", "--size", "2", "--format", "ascii", testPlayPuzzle},
+			stdin:      "d\n4\nc\nk\nc\nk\nc\n",
+			wantStatus: exitUnsolvable,
+			wantOut:    []string{"> No wrong cells\n> Checking against the solution\n> Wrong cells: r1c2\n> Checking the conflicts\n> No wrong cells\n"},
+		},
+		{
+			name:       "a symbol is a value before a move",
+			args:       []string{"play", "--size", "6", strings.Repeat("0", 36*36)},
+			stdin:      "a\nq\n",
+			wantStatus: exitUnsolvable,
+			wantOut:    []string{"║>a<│"},
+		},
+		{
+			name:       "colors",
+			args:       []string{"play", "--size", "2", "--format", "color", testPlayPuzzle},
+			stdin:      "q\n",
+			wantStatus: exitUnsolvable,
+			wantOut:    []string{"║>\x1b[1m1\x1b[0m<│"},
+		},
+		{
+			name:       "generated",
+			args:       []string{"play", "--size", "2", "--seed", "3"},
+			wantStatus: exitUnsolvable,
+			wantOut:    []string{"r1c1  10s\n> \n"},
+		},
+		{
+			name:       "invalid puzzle",
+			args:       []string{"play", "--size", "2", "11.............."},
+			wantStatus: exitInvalid,
+			wantErr:    "invalid: Given conflicts with position 0 '1' at position 1 (row 0, column 1)\n",
+		},
+		{
+			name:       "invalid format",
+			args:       []string{"play", "--format", "compact"},
+			wantStatus: exitUsage,
+			wantErr:    "Invalid format \"compact\", valid formats to play are pretty, ascii and color\n",
+		},
+		{
+			name:       "invalid size",
+			args:       []string{"play", "--size", "7"},
+			wantStatus: exitUsage,
+			wantErr:    "Invalid size 7, valid sizes to play are 2 to 6\n",
+		},
+		{
+			name:       "generated size",
+			args:       []string{"play", "--size", "5"},
+			wantStatus: exitUsage,
+			wantErr:    "Invalid size 5, valid sizes to generate are 2 to 4\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock := start
+			now = func() time.Time {
+				clock = clock.Add(10 * time.Second)
+				return clock
+			}
+			defer func() { now = time.Now }()
+			var stdout, stderr bytes.Buffer
+			status := run(tt.args, strings.NewReader(tt.stdin), &stdout, &stderr)
+			if status != tt.wantStatus {
+				t.Errorf("run() status = %v, want %v", status, tt.wantStatus)
+			}
+			for _, want := range tt.wantOut {
+				if !strings.Contains(stdout.String(), want) {
+					t.Errorf("run() stdout = %q, want %q", stdout.String(), want)
+				}
+			}
+			if res := stderr.String(); res != tt.wantErr {
+				t.Errorf("run() stderr = %q, want %q", res, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/rfiestas/sodogo/server"
+)
+
+// serverFlags serve command flags
+type serverFlags struct {
+	addr          string
+	timeout       time.Duration
+	maxConcurrent int
+}
+
+// listenAndServe starts the HTTP server, replaced by the tests
+var listenAndServe = (*http.Server).ListenAndServe
+
+func (c *command) serve() int {
+	srv := &http.Server{
+		Addr: c.server.addr,
+		Handler: server.New(server.Config{
+			Timeout:       c.server.timeout,
+			MaxConcurrent: c.server.maxConcurrent,
+		}),
+		ReadHeaderTimeout: 5 * time.Second,
+		ReadTimeout:       c.server.timeout,
+		WriteTimeout:      c.server.timeout + 5*time.Second,
+	}
+	fmt.Fprintf(c.stderr, "Listening on %s\n", c.server.addr)
+	if err := listenAndServe(srv); err != nil && err != http.ErrServerClosed {
+		fmt.Fprintln(c.stderr, err)
+		return exitUsage
+	}
+	return exitSolved
+}
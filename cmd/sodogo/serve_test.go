@@ -0,0 +1,41 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestServe(t *testing.T) {
+	defer func(f func(*http.Server) error) { listenAndServe = f }(listenAndServe)
+
+	var srv *http.Server
+	listenAndServe = func(s *http.Server) error {
+		srv = s
+		return http.ErrServerClosed
+	}
+	var stdout, stderr bytes.Buffer
+	status := run([]string{"serve", "--addr", "127.0.0.1:9999", "--timeout", "2s"}, nil, &stdout, &stderr)
+	if status != exitSolved {
+		t.Fatalf("run() status = %v, stderr %v", status, stderr.String())
+	}
+	if srv.Addr != "127.0.0.1:9999" || srv.ReadTimeout != 2*time.Second {
+		t.Errorf("serve() server = %v %v, want 127.0.0.1:9999 2s", srv.Addr, srv.ReadTimeout)
+	}
+
+	rec := httptest.NewRecorder()
+	srv.Handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/solve", strings.NewReader(`{"board":"`+testPuzzle+`"}`)))
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), testSolution) {
+		t.Errorf("serve() /solve = %v %v", rec.Code, rec.Body.String())
+	}
+
+	listenAndServe = func(s *http.Server) error { return errors.New("address in use") }
+	stderr.Reset()
+	if status := run([]string{"serve"}, nil, &stdout, &stderr); status != exitUsage || !strings.HasSuffix(stderr.String(), "address in use\n") {
+		t.Errorf("run() status = %v, stderr %v, want the listen error", status, stderr.String())
+	}
+}
@@ -0,0 +1,314 @@
+package sodogo
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+/*
+     Encoding example, 2x2 board
+
+  text: 2:1000000000000000:1234000000000000
+  json: {"size":2,"givens":"1000000000000000","values":"1234000000000000"}
+
+  Candidates, as CandidatesString, are added when an empty cell has known
+  potential values. Extra groups, parity, markers and outside clues are only
+  encoded as JSON, in the order they were added:
+
+  json: {"size":2,"givens":"1000000000000000","values":"1234000000000000",
+         "parity":"o...............","rules":[{"kind":"greater",
+         "first":{"row":0,"col":1},"second":{"row":0,"col":0}},
+         {"kind":"sandwich","side":"top","index":1,"sum":5}]}
+*/
+
+// maxEncodedSize the biggest decoded size, the default alphabet symbols are
+// enough up to 7
+const maxEncodedSize = 7
+
+// boardJSON board JSON representation
+type boardJSON struct {
+	Size       int        `json:"size"`                 // flats size, 3 for a 9x9 board
+	Alphabet   string     `json:"alphabet,omitempty"`   // only for non default alphabets
+	Givens     string     `json:"givens"`               // puzzle values
+	Values     string     `json:"values"`               // givens and filled values
+	Candidates string     `json:"candidates,omitempty"` // potential values, as CandidatesString
+	Groups     [][]int    `json:"groups,omitempty"`     // extra groups, cell positions from 0
+	Parity     string     `json:"parity,omitempty"`     // parity mask, as LoadParityFromString
+	Rules      []ruleJSON `json:"rules,omitempty"`      // markers and outside clues
+}
+
+// ruleJSON marker or outside clue JSON representation, rows and columns from 0
+type ruleJSON struct {
+	Kind      string    `json:"kind"`                // greater, xv, xvNegative, sandwich or littleKiller
+	First     *cellJSON `json:"first,omitempty"`     // marker first cell
+	Second    *cellJSON `json:"second,omitempty"`    // marker second cell
+	Side      string    `json:"side,omitempty"`      // clue side: top, bottom, left or right
+	Index     int       `json:"index,omitempty"`     // clue row or column
+	Direction string    `json:"direction,omitempty"` // little killer diagonal: downRight, downLeft, upRight or upLeft
+	Sum       int       `json:"sum,omitempty"`       // XV, sandwich or little killer sum
+}
+
+// cellJSON cell JSON representation, rows and columns from 0
+type cellJSON struct {
+	Row int `json:"row"`
+	Col int `json:"col"`
+}
+
+// sideNames and diagonalNames clue sides and diagonals JSON names
+var (
+	sideNames     = []string{"top", "bottom", "left", "right"}
+	diagonalNames = []string{"downRight", "downLeft", "upRight", "upLeft"}
+)
+
+// MarshalText encodes the board as size:givens:values[:candidates]
+func (b *Board) MarshalText() ([]byte, error) {
+	if b.helpers.alphabet != b.helpers.generateAlphabet() {
+		return nil, fmt.Errorf("Boards with custom alphabets can only be encoded as JSON")
+	}
+	e, err := b.encode()
+	if err != nil {
+		return nil, err
+	}
+	if len(e.Groups) > 0 || e.Parity != "" || len(e.Rules) > 0 {
+		return nil, fmt.Errorf("Boards with extra groups, parity, markers or outside clues can only be encoded as JSON")
+	}
+	res := strings.Join([]string{strconv.Itoa(e.Size), e.Givens, e.Values}, ":")
+	if e.Candidates != "" {
+		res += ":" + e.Candidates
+	}
+	return []byte(res), nil
+}
+
+// UnmarshalText decodes a board encoded as size:givens:values[:candidates]
+func (b *Board) UnmarshalText(text []byte) error {
+	fields := strings.Split(string(text), ":")
+	if len(fields) < 3 || len(fields) > 4 {
+		return fmt.Errorf("A valid board text contains size:givens:values[:candidates], not %q", text)
+	}
+	size, err := strconv.Atoi(fields[0])
+	if err != nil {
+		return fmt.Errorf("Invalid board size %q", fields[0])
+	}
+	e := boardJSON{Size: size, Givens: fields[1], Values: fields[2]}
+	if len(fields) == 4 {
+		e.Candidates = fields[3]
+	}
+	return b.decode(e)
+}
+
+// MarshalJSON encodes the board as JSON
+func (b *Board) MarshalJSON() ([]byte, error) {
+	e, err := b.encode()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(e)
+}
+
+// UnmarshalJSON decodes a board encoded as JSON
+func (b *Board) UnmarshalJSON(data []byte) error {
+	var e boardJSON
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	return b.decode(e)
+}
+
+// GobEncode encodes the board for gob, using its JSON representation
+func (b *Board) GobEncode() ([]byte, error) {
+	return b.MarshalJSON()
+}
+
+// GobDecode decodes a board encoded for gob
+func (b *Board) GobDecode(data []byte) error {
+	return b.UnmarshalJSON(data)
+}
+
+// encode returns the board representation
+func (b *Board) encode() (e boardJSON, err error) {
+	var givens bytes.Buffer
+	for pos := 0; pos < b.helpers.boardSize; pos++ {
+		value := 0
+		if b.data[pos].given {
+			value = b.getValue(pos)
+		}
+		givens.WriteByte(b.helpers.getSymbol(value))
+	}
+
+	e = boardJSON{
+		Size:   b.helpers.flats,
+		Givens: givens.String(),
+		Values: b.String(),
+	}
+	if b.helpers.alphabet != b.helpers.generateAlphabet() {
+		e.Alphabet = b.helpers.alphabet
+	}
+	if b.hasCandidates() {
+		e.Candidates = b.CandidatesString()
+	}
+	e.Groups = b.helpers.extraGroups
+	if b.hasParity() {
+		e.Parity = b.parityString()
+	}
+	for _, c := range b.constraints {
+		e.Rules = append(e.Rules, encodeRule(c))
+	}
+	return e, nil
+}
+
+// encodeRule returns the representation of a marker or outside clue
+func encodeRule(c constraint) ruleJSON {
+	switch r := c.(type) {
+	case GreaterThan:
+		return ruleJSON{Kind: "greater", First: &cellJSON{r.First.Row, r.First.Col}, Second: &cellJSON{r.Second.Row, r.Second.Col}}
+	case XV:
+		return ruleJSON{Kind: "xv", First: &cellJSON{r.First.Row, r.First.Col}, Second: &cellJSON{r.Second.Row, r.Second.Col}, Sum: r.Sum}
+	case Sandwich:
+		return ruleJSON{Kind: "sandwich", Side: sideNames[r.Side], Index: r.Index, Sum: r.Sum}
+	case LittleKiller:
+		return ruleJSON{Kind: "littleKiller", Side: sideNames[r.Side], Index: r.Index, Direction: diagonalNames[r.Direction], Sum: r.Sum}
+	}
+	return ruleJSON{Kind: "xvNegative"}
+}
+
+// decodeRules adds the markers and outside clues of their representations
+func (b *Board) decodeRules(rules []ruleJSON) error {
+	for num, r := range rules {
+		var err error
+		switch r.Kind {
+		case "greater", "xv":
+			if r.First == nil || r.Second == nil {
+				return fmt.Errorf("Rule %d: A valid %s marker contains its first and second cells", num, r.Kind)
+			}
+			first, second := Position{r.First.Row, r.First.Col}, Position{r.Second.Row, r.Second.Col}
+			if r.Kind == "greater" {
+				err = b.AddMarkers(GreaterThan{first, second})
+			} else {
+				err = b.AddMarkers(XV{first, second, r.Sum})
+			}
+		case "xvNegative":
+			b.AddXVNegative()
+		case "sandwich", "littleKiller":
+			side := indexOf(sideNames, r.Side)
+			if side < 0 {
+				return fmt.Errorf("Rule %d: Unknown clue side %q", num, r.Side)
+			}
+			if r.Kind == "sandwich" {
+				err = b.AddClues(Sandwich{Side(side), r.Index, r.Sum})
+				break
+			}
+			direction := indexOf(diagonalNames, r.Direction)
+			if direction < 0 {
+				return fmt.Errorf("Rule %d: Unknown little killer direction %q", num, r.Direction)
+			}
+			err = b.AddClues(LittleKiller{Side(side), r.Index, Diagonal(direction), r.Sum})
+		default:
+			return fmt.Errorf("Rule %d: Unknown rule kind %q", num, r.Kind)
+		}
+		if err != nil {
+			return fmt.Errorf("Rule %d: %v", num, err)
+		}
+	}
+	return nil
+}
+
+// checkGroups returns an error when an extra group is not a set of distinct
+// cells of the board, as many as the values
+func (h HelperBoard) checkGroups(groups [][]int) error {
+	for num, group := range groups {
+		if len(group) != h.maxValue {
+			return fmt.Errorf("A valid extra group contains %d cells, group %d contains %d", h.maxValue, num, len(group))
+		}
+		found := map[int]bool{}
+		for _, pos := range group {
+			if pos < 0 || pos >= h.boardSize || found[pos] {
+				return fmt.Errorf("Invalid cell %d in extra group %d, valid cells are 0 to %d without repeats", pos, num, h.boardSize-1)
+			}
+			found[pos] = true
+		}
+	}
+	return nil
+}
+
+// indexOf returns the index of a name, -1 when it is not found
+func indexOf(names []string, name string) int {
+	for num, n := range names {
+		if n == name {
+			return num
+		}
+	}
+	return -1
+}
+
+// decode replaces the board with a board representation
+func (b *Board) decode(e boardJSON) error {
+	if e.Size < 1 || e.Size > maxEncodedSize {
+		return fmt.Errorf("Invalid board size %d, valid sizes are 1 to %d", e.Size, maxEncodedSize)
+	}
+	if boardSize := e.Size * e.Size * e.Size * e.Size; len(e.Givens) != boardSize {
+		return fmt.Errorf("A valid board definition contains %d caracters, not %d", boardSize, len(e.Givens))
+	}
+	h, err := NewCheckedHelperBoard(e.Size)
+	if err != nil {
+		return err
+	}
+	if e.Alphabet != "" {
+		if h, err = h.WithAlphabet(e.Alphabet); err != nil {
+			return err
+		}
+	}
+	if err := h.checkGroups(e.Groups); err != nil {
+		return err
+	}
+	h.extraGroups = e.Groups
+
+	res := NewBoard(h)
+	if err := res.LoadFromStringStrict(e.Givens); err != nil {
+		return err
+	}
+	if e.Parity != "" {
+		if err := res.LoadParityFromString(e.Parity); err != nil {
+			return err
+		}
+	}
+	if err := res.decodeRules(e.Rules); err != nil {
+		return err
+	}
+	if len(e.Values) != h.boardSize {
+		return fmt.Errorf("A valid board definition contains %d caracters, not %d", h.boardSize, len(e.Values))
+	}
+	for pos := 0; pos < h.boardSize; pos++ {
+		value := h.getSymbolValue(e.Values[pos])
+		if res.data[pos].given && value != res.getValue(pos) {
+			return res.newLoadError(pos, e.Values[pos], "Value differs from the given")
+		}
+		if value != 0 && !res.data[pos].given {
+			res.setValue(pos, value)
+		}
+	}
+	if e.Candidates != "" {
+		candidates, err := res.parseCandidatesString(e.Candidates)
+		if err != nil {
+			return err
+		}
+		res.setCandidates(candidates)
+	}
+
+	b.data, b.helpers, b.constraints = res.data, res.helpers, res.constraints
+	b.Steps, b.Elapsed = 0, 0
+	return nil
+}
+
+// hasCandidates returns if an empty cell has known potential values
+func (b *Board) hasCandidates() bool {
+	for pos := 0; pos < b.helpers.boardSize; pos++ {
+		values := b.getPotential(pos)
+		if b.getValue(pos) == 0 && values != nil && !(len(values) == 1 && values[0] == 0) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,237 @@
+package sodogo
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+// test2x2BoardFilled returns a board with a given and filled cells
+func test2x2BoardFilled() (b *Board) {
+	b = NewBoard(NewHelperBoard(2))
+	_ = b.LoadFromString("1000000000000000")
+	b.setValue(1, 2)
+	b.setValue(2, 3)
+	b.setValue(3, 4)
+	return b
+}
+
+func TestBoard_MarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		b       *Board
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "2x2",
+			b:    test2x2BoardFilled(),
+			want: "2:1000000000000000:1234000000000000",
+		},
+		{
+			name: "2x2 candidates",
+			b:    test2x2BoardMidSolve(),
+			want: "2:1002000000000000:1002000000000000:1000001100110100011101111011101101111111111110110111111111111011",
+		},
+		{
+			name: "2x2 alphabet",
+			b: func() *Board {
+				h, _ := NewHelperBoard(2).WithAlphabet("ABCD")
+				return NewBoard(h)
+			}(),
+			wantErr: true,
+		},
+		{
+			name:    "2x2 extra groups",
+			b:       test2x2BoardHyper(),
+			wantErr: true,
+		},
+		{
+			name:    "2x2 parity",
+			b:       test2x2BoardParity(),
+			wantErr: true,
+		},
+		{
+			name:    "2x2 markers",
+			b:       test2x2BoardComparison(),
+			wantErr: true,
+		},
+		{
+			name: "2x2 outside clues",
+			b: func() *Board {
+				b := test2x2BoardFilled()
+				_ = b.AddClues(Sandwich{Top, 0, 5})
+				return b
+			}(),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := tt.b.MarshalText()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Board.MarshalText() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if string(res) != tt.want {
+				t.Errorf("Board.MarshalText() res = %v, want %v", string(res), tt.want)
+			}
+		})
+	}
+}
+
+func TestBoard_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		wantErr bool
+	}{
+		{
+			name: "2x2",
+			text: "2:1000000000000000:1234000000000000",
+		},
+		{
+			name: "2x2 candidates",
+			text: "2:1002000000000000:1002000000000000:1000001100110100011101111011101101111111111110110111111111111011",
+		},
+		{
+			name:    "2x2 missing values",
+			text:    "2:1000000000000000",
+			wantErr: true,
+		},
+		{
+			name:    "2x2 wrong size",
+			text:    "x:1000000000000000:1234000000000000",
+			wantErr: true,
+		},
+		{
+			name:    "too big size",
+			text:    "1000:0:0",
+			wantErr: true,
+		},
+		{
+			name:    "2x2 missing givens",
+			text:    "2:100000000000000:1234000000000000",
+			wantErr: true,
+		},
+		{
+			name:    "2x2 values differ from givens",
+			text:    "2:1000000000000000:2234000000000000",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Board{}
+			err := b.UnmarshalText([]byte(tt.text))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Board.UnmarshalText() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if res, _ := b.MarshalText(); err == nil && string(res) != tt.text {
+				t.Errorf("Board.UnmarshalText() res = %v, want %v", string(res), tt.text)
+			}
+		})
+	}
+}
+
+func TestBoard_MarshalJSON(t *testing.T) {
+	b := test2x2BoardFilled()
+	res, err := json.Marshal(b)
+	want := `{"size":2,"givens":"1000000000000000","values":"1234000000000000"}`
+	if err != nil || string(res) != want {
+		t.Fatalf("Board.MarshalJSON() res = %v %v, want %v", string(res), err, want)
+	}
+
+	var decoded *Board
+	if err := json.Unmarshal(res, &decoded); err != nil {
+		t.Fatalf("Board.UnmarshalJSON() error = %v", err)
+	}
+	if decoded.String() != b.String() || !decoded.data[0].given || decoded.data[1].given {
+		t.Errorf("Board.UnmarshalJSON() res = %v, want %v", decoded.String(), b.String())
+	}
+}
+
+func TestBoard_MarshalJSONAlphabet(t *testing.T) {
+	h, _ := NewHelperBoard(2).WithAlphabet("ABCD")
+	b := NewBoard(h)
+	_ = b.LoadFromString("A000000000000000")
+	res, _ := json.Marshal(b)
+	want := `{"size":2,"alphabet":"ABCD","givens":"A000000000000000","values":"A000000000000000"}`
+	if string(res) != want {
+		t.Fatalf("Board.MarshalJSON() res = %v, want %v", string(res), want)
+	}
+	var decoded *Board
+	if err := json.Unmarshal(res, &decoded); err != nil || decoded.String() != b.String() {
+		t.Errorf("Board.UnmarshalJSON() res = %v %v, want %v", decoded.String(), err, b.String())
+	}
+}
+
+func TestBoard_MarshalJSONRules(t *testing.T) {
+	rules := NewBoard(NewHelperBoard(2))
+	_ = rules.LoadFromString("1000000000000000")
+	_ = rules.LoadParityFromString("o...............")
+	_ = rules.AddMarkers(GreaterThan{Position{0, 1}, Position{0, 0}})
+	_ = rules.AddClues(Sandwich{Top, 1, 5})
+	res, err := json.Marshal(rules)
+	want := `{"size":2,"givens":"1000000000000000","values":"1000000000000000","parity":"o...............",` +
+		`"rules":[{"kind":"greater","first":{"row":0,"col":1},"second":{"row":0,"col":0}},{"kind":"sandwich","side":"top","index":1,"sum":5}]}`
+	if err != nil || string(res) != want {
+		t.Errorf("Board.MarshalJSON() res = %s %v, want %s", res, err, want)
+	}
+
+	clues := test2x2BoardFilled()
+	_ = clues.AddMarkers(XV{Position{1, 0}, Position{1, 1}, X})
+	clues.AddXVNegative()
+	_ = clues.AddClues(LittleKiller{Left, 1, UpRight, 4}, Sandwich{Bottom, 3, 0})
+	disjoint := NewBoard(NewHelperBoard(2).WithDisjointGroups().WithHyper())
+	_ = disjoint.LoadFromString("1000000000000000")
+
+	for _, b := range []*Board{rules, clues, test2x2BoardHyper(), test2x2BoardParity(), test2x2BoardComparison(), disjoint} {
+		data, err := json.Marshal(b)
+		if err != nil {
+			t.Fatalf("Board.MarshalJSON() error = %v", err)
+		}
+		var decoded *Board
+		if err := json.Unmarshal(data, &decoded); err != nil || !decoded.Equal(b) {
+			t.Errorf("Board.UnmarshalJSON(%s) = %v %v, want the encoded board", data, decoded, err)
+		}
+	}
+
+	errors := []struct {
+		data string
+		want string
+	}{
+		{`{"size":2,"givens":"1000000000000000","values":"1000000000000000","groups":[[0,1,2]]}`, "A valid extra group contains 4 cells, group 0 contains 3"},
+		{`{"size":2,"givens":"1000000000000000","values":"1000000000000000","groups":[[0,1,2,2]]}`, "Invalid cell 2 in extra group 0, valid cells are 0 to 15 without repeats"},
+		{`{"size":2,"givens":"1000000000000000","values":"1000000000000000","parity":"x"}`, "A valid parity mask contains 16 caracters, not 1"},
+		{`{"size":2,"givens":"1000000000000000","values":"1000000000000000","rules":[{"kind":"killer"}]}`, `Rule 0: Unknown rule kind "killer"`},
+		{`{"size":2,"givens":"1000000000000000","values":"1000000000000000","rules":[{"kind":"xv"}]}`, "Rule 0: A valid xv marker contains its first and second cells"},
+		{`{"size":2,"givens":"1000000000000000","values":"1000000000000000","rules":[{"kind":"xv","first":{"row":0,"col":0},"second":{"row":0,"col":1},"sum":4}]}`, "Rule 0: Invalid XV sum 4 at r1c1 r1c2, valid sums are 5 (V) and 10 (X)"},
+		{`{"size":2,"givens":"1000000000000000","values":"1000000000000000","rules":[{"kind":"sandwich","side":"up"}]}`, `Rule 0: Unknown clue side "up"`},
+		{`{"size":2,"givens":"1000000000000000","values":"1000000000000000","rules":[{"kind":"littleKiller","side":"top","direction":"down"}]}`, `Rule 0: Unknown little killer direction "down"`},
+	}
+	for _, tt := range errors {
+		var b Board
+		if err := json.Unmarshal([]byte(tt.data), &b); err == nil || err.Error() != tt.want {
+			t.Errorf("Board.UnmarshalJSON(%s) err = %v, want %v", tt.data, err, tt.want)
+		}
+	}
+}
+
+func TestBoard_GobEncode(t *testing.T) {
+	b := test2x2BoardMidSolve()
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode(b); err != nil {
+		t.Fatalf("Board.GobEncode() error = %v", err)
+	}
+	var decoded *Board
+	if err := gob.NewDecoder(&buffer).Decode(&decoded); err != nil {
+		t.Fatalf("Board.GobDecode() error = %v", err)
+	}
+	for pos := 0; pos < 16; pos++ {
+		if !reflect.DeepEqual(decoded.getExportedCandidates(pos), b.getExportedCandidates(pos)) {
+			t.Errorf("Board.GobDecode() potential %d = %v, want %v", pos, decoded.getExportedCandidates(pos), b.getExportedCandidates(pos))
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package sodogo
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+/*
+     SadMan .sdk example, metadata lines start with #
+
+  #Aauthor
+  #Ddescription
+  ..43..2.9
+  ..5..9..1
+  ...
+
+     Simple Sudoku .ss example
+
+  ..4|3..|2.9
+  ..5|..9|..1
+  .7.|.6.|.43
+  -----------
+  ...
+
+     .sdm collection, a puzzle per line
+
+  004300209005009001070060043006002087190007400050083000600000105003508690042910300
+  ...
+
+*/
+
+// Puzzle a board with the metadata of its file, like the SadMan "A" author
+// or "D" description
+type Puzzle struct {
+	Board    *Board
+	Metadata map[string]string
+}
+
+// ReadSDK reads a SadMan .sdk puzzle
+func ReadSDK(r io.Reader, h HelperBoard) (p Puzzle, err error) {
+	p = Puzzle{
+		Board:    NewBoard(h),
+		Metadata: map[string]string{},
+	}
+	var grid strings.Builder
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if strings.HasPrefix(line, "#") {
+			if len(line) > 1 {
+				key, value := line[1:2], line[2:]
+				if previous, ok := p.Metadata[key]; ok {
+					value = previous + "\n" + value
+				}
+				p.Metadata[key] = value
+			}
+			continue
+		}
+		grid.WriteString(line + "\n")
+	}
+	if err := scanner.Err(); err != nil {
+		return p, err
+	}
+	return p, p.Board.LoadFromText(grid.String())
+}
+
+// WriteSDK writes a SadMan .sdk puzzle
+func WriteSDK(w io.Writer, p Puzzle) error {
+	keys := []string{}
+	for key := range p.Metadata {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		for _, value := range strings.Split(p.Metadata[key], "\n") {
+			if _, err := fmt.Fprintf(w, "#%s%s\n", key, value); err != nil {
+				return err
+			}
+		}
+	}
+	return writeRows(w, p.Board, false)
+}
+
+// ReadSS reads a Simple Sudoku .ss puzzle
+func ReadSS(r io.Reader, h HelperBoard) (b *Board, err error) {
+	b = NewBoard(h)
+	text, err := io.ReadAll(r)
+	if err != nil {
+		return b, err
+	}
+	return b, b.LoadFromText(string(text))
+}
+
+// WriteSS writes a Simple Sudoku .ss puzzle
+func WriteSS(w io.Writer, b *Board) error {
+	return writeRows(w, b, true)
+}
+
+// ReadSDM reads a .sdm collection, a puzzle per line, empty lines are skipped
+func ReadSDM(r io.Reader, h HelperBoard) (boards []*Board, err error) {
+	scanner := bufio.NewScanner(r)
+	for num := 1; scanner.Scan(); num++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		b := NewBoard(h)
+		if err := b.LoadFromText(line); err != nil {
+			return boards, fmt.Errorf("line %d: %v", num, err)
+		}
+		boards = append(boards, b)
+	}
+	return boards, scanner.Err()
+}
+
+// WriteSDM writes a .sdm collection, a puzzle per line
+func WriteSDM(w io.Writer, boards []*Board) error {
+	for _, b := range boards {
+		if _, err := fmt.Fprintln(w, b.String()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeRows writes a row per line with '.' for empty cells, separated writes
+// '|' between flats and '-' lines between flat rows
+func writeRows(w io.Writer, b *Board, separated bool) error {
+	values := strings.Replace(b.String(), "0", ".", -1)
+	maxValue, flats := b.helpers.maxValue, b.helpers.flats
+	for row := 0; row < maxValue; row++ {
+		if separated && row != 0 && row%flats == 0 {
+			if _, err := fmt.Fprintln(w, strings.Repeat("-", maxValue+flats-1)); err != nil {
+				return err
+			}
+		}
+		var line strings.Builder
+		for col := 0; col < maxValue; col++ {
+			if separated && col != 0 && col%flats == 0 {
+				line.WriteString("|")
+			}
+			line.WriteByte(values[row*maxValue+col])
+		}
+		if _, err := fmt.Fprintln(w, line.String()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+package sodogo
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReadSDK(t *testing.T) {
+	tests := []struct {
+		name         string
+		text         string
+		want         string
+		wantMetadata map[string]string
+		wantErr      bool
+	}{
+		{
+			name:         "2x2",
+			text:         "#Aauthor\n#Ddescription\n#Cfirst\n#Csecond\n1...\n..21\n....\n....\n",
+			want:         "1000002100000000",
+			wantMetadata: map[string]string{"A": "author", "D": "description", "C": "first\nsecond"},
+		},
+		{
+			name:         "2x2 without metadata",
+			text:         "1...\r\n..21\r\n....\r\n....\r\n",
+			want:         "1000002100000000",
+			wantMetadata: map[string]string{},
+		},
+		{
+			name:    "2x2 short",
+			text:    "#Aauthor\n1...\n..21\n",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := ReadSDK(strings.NewReader(tt.text), NewHelperBoard(2))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ReadSDK() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if res := p.Board.String(); res != tt.want {
+				t.Errorf("ReadSDK() board = %v, want %v", res, tt.want)
+			}
+			if !reflect.DeepEqual(p.Metadata, tt.wantMetadata) {
+				t.Errorf("ReadSDK() metadata = %v, want %v", p.Metadata, tt.wantMetadata)
+			}
+		})
+	}
+}
+
+func TestWriteSDK(t *testing.T) {
+	b := NewBoard(NewHelperBoard(2))
+	_ = b.LoadFromString("1000002100000000")
+	p := Puzzle{Board: b, Metadata: map[string]string{"D": "description", "A": "author", "C": "first\nsecond"}}
+	want := "#Aauthor\n#Cfirst\n#Csecond\n#Ddescription\n1...\n..21\n....\n....\n"
+
+	var buffer bytes.Buffer
+	if err := WriteSDK(&buffer, p); err != nil {
+		t.Fatalf("WriteSDK() error = %v", err)
+	}
+	if res := buffer.String(); res != want {
+		t.Errorf("WriteSDK() res = %q, want %q", res, want)
+	}
+
+	read, err := ReadSDK(&buffer, NewHelperBoard(2))
+	if err != nil {
+		t.Fatalf("ReadSDK() error = %v", err)
+	}
+	if !reflect.DeepEqual(read.Metadata, p.Metadata) || read.Board.String() != b.String() {
+		t.Errorf("ReadSDK() res = %v %v, want %v %v", read.Board.String(), read.Metadata, b.String(), p.Metadata)
+	}
+}
+
+func TestReadSS(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "2x2",
+			text: "1.|..\n..|21\n-----\n..|..\n..|..\n",
+			want: "1000002100000000",
+		},
+		{
+			name:    "2x2 invalid caracter",
+			text:    "1.|..\n..|2x\n-----\n..|..\n..|..\n",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := ReadSS(strings.NewReader(tt.text), NewHelperBoard(2))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ReadSS() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && b.String() != tt.want {
+				t.Errorf("ReadSS() res = %v, want %v", b.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestWriteSS(t *testing.T) {
+	tests := []struct {
+		name  string
+		board string
+		flats int
+		want  string
+	}{
+		{
+			name:  "2x2",
+			board: "1000002100000000",
+			flats: 2,
+			want:  "1.|..\n..|21\n-----\n..|..\n..|..\n",
+		},
+		{
+			name:  "3x3",
+			board: "004300209005009001070060043006002087190007400050083000600000105003508690042910300",
+			flats: 3,
+			want: "..4|3..|2.9\n..5|..9|..1\n.7.|.6.|.43\n-----------\n" +
+				"..6|..2|.87\n19.|..7|4..\n.5.|.83|...\n-----------\n" +
+				"6..|...|1.5\n..3|5.8|69.\n.42|91.|3..\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewBoard(NewHelperBoard(tt.flats))
+			_ = b.LoadFromString(tt.board)
+			var buffer bytes.Buffer
+			if err := WriteSS(&buffer, b); err != nil {
+				t.Fatalf("WriteSS() error = %v", err)
+			}
+			if res := buffer.String(); res != tt.want {
+				t.Errorf("WriteSS() res = %q, want %q", res, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadSDM(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "2x2",
+			text: "1000002100000000\n\n.2........12....\n",
+			want: []string{"1000002100000000", "0200000000120000"},
+		},
+		{
+			name:    "2x2 invalid line",
+			text:    "1000002100000000\n10000021\n",
+			want:    []string{"1000002100000000"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			boards, err := ReadSDM(strings.NewReader(tt.text), NewHelperBoard(2))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ReadSDM() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			res := []string{}
+			for num := range boards {
+				res = append(res, boards[num].String())
+			}
+			if !reflect.DeepEqual(res, tt.want) {
+				t.Errorf("ReadSDM() res = %v, want %v", res, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriteSDM(t *testing.T) {
+	boards, _ := ReadSDM(strings.NewReader("1000002100000000\n0200000000120000\n"), NewHelperBoard(2))
+	want := "1000002100000000\n0200000000120000\n"
+
+	var buffer bytes.Buffer
+	if err := WriteSDM(&buffer, boards); err != nil {
+		t.Fatalf("WriteSDM() error = %v", err)
+	}
+	if res := buffer.String(); res != want {
+		t.Errorf("WriteSDM() res = %q, want %q", res, want)
+	}
+}
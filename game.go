@@ -0,0 +1,326 @@
+package sodogo
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+)
+
+/*
+     Game example, 2x2 board
+
+  g := NewGame(puzzle)
+  g.Set(Position{0, 1}, 2)        // history: set r1c2 2
+  g.ToggleMark(Position{0, 3}, 4) // history: set r1c2 2, mark r1c4 4
+  g.Undo()                        // the mark is undone, Redo applies it again
+  g.GoTo(0)                       // back to the puzzle, every move can be redone
+
+  json: {"puzzle":{"size":2,...},"moves":[{"kind":"set","row":0,"col":1,"value":2},
+         {"kind":"mark","row":0,"col":3,"value":4}],"current":0,"elapsed":95000000000}
+*/
+
+// MoveKind the kind of a player move
+type MoveKind int
+
+// Player move kinds
+const (
+	MoveSet   MoveKind = iota // enters a value
+	MoveClear                 // clears a value
+	MoveMark                  // toggles a pencil mark
+)
+
+// moveKinds move kinds JSON names
+var moveKinds = []string{"set", "clear", "mark"}
+
+// Move a player move, Value is not used by MoveClear
+type Move struct {
+	Kind     MoveKind
+	Position Position
+	Value    int
+}
+
+// Game a player session on a puzzle: the givens are read-only and every move
+// is kept in a history to undo, redo or go to any earlier point
+type Game struct {
+	puzzle   *Board           // the board when the game started
+	board    *Board           // the board after the current moves
+	marks    [][]int          // pencil marks by cell
+	history  []gameMove       // every move, the undone moves after current
+	current  int              // number of applied moves
+	mode     CheckMode        // how the moves are checked
+	solution []int            // puzzle solution, for CheckStrict
+	units    [][]int          // every unit, as getUnits
+	unitsOf  [][]int          // units numbers by cell
+	played   time.Duration    // playing time before start
+	start    time.Time        // when the clock started, NewGame or the decoding
+	now      func() time.Time // the clock, time.Now by default
+}
+
+// gameMove a move in the history and the value it replaced
+type gameMove struct {
+	Move
+	previous int
+}
+
+// gameJSON game JSON representation
+type gameJSON struct {
+	Puzzle  *Board        `json:"puzzle"`          // the board when the game started
+	Moves   []moveJSON    `json:"moves"`           // every move, the undone moves after current
+	Current int           `json:"current"`         // number of applied moves
+	Check   string        `json:"check,omitempty"` // check mode, conflicts by default
+	Elapsed time.Duration `json:"elapsed"`         // playing time, in nanoseconds
+}
+
+// moveJSON move JSON representation, rows and columns from 0
+type moveJSON struct {
+	Kind  string `json:"kind"`            // set, clear or mark
+	Row   int    `json:"row"`             // cell row
+	Col   int    `json:"col"`             // cell column
+	Value int    `json:"value,omitempty"` // set value or toggled mark
+}
+
+// NewGame returns a game of a puzzle, its givens are read-only
+func NewGame(puzzle *Board) *Game {
+	g := &Game{
+		puzzle: puzzle.Clone(),
+		board:  puzzle.Clone(),
+		marks:  make([][]int, puzzle.helpers.boardSize),
+		units:  puzzle.helpers.getUnits(),
+		now:    time.Now,
+	}
+	g.unitsOf = make([][]int, puzzle.helpers.boardSize)
+	for num, unit := range g.units {
+		for _, pos := range unit {
+			g.unitsOf[pos] = append(g.unitsOf[pos], num)
+		}
+	}
+	g.start = g.now()
+	return g
+}
+
+// Board returns a copy of the board after the current moves
+func (g *Game) Board() *Board {
+	return g.board.Clone()
+}
+
+// Marks returns the pencil marks of a cell, in order
+func (g *Game) Marks(p Position) []int {
+	if !g.board.inBoard(p) {
+		return nil
+	}
+	return append([]int{}, g.marks[g.board.getPos(p)]...)
+}
+
+// Set enters a value in a cell
+func (g *Game) Set(p Position, value int) error {
+	if value < 1 || value > g.board.helpers.maxValue {
+		return fmt.Errorf("Invalid value %d, valid values are 1 to %d", value, g.board.helpers.maxValue)
+	}
+	return g.play(Move{Kind: MoveSet, Position: p, Value: value})
+}
+
+// Clear clears the value of a cell
+func (g *Game) Clear(p Position) error {
+	return g.play(Move{Kind: MoveClear, Position: p})
+}
+
+// ToggleMark adds a pencil mark to a cell, or removes it when present
+func (g *Game) ToggleMark(p Position, value int) error {
+	if value < 1 || value > g.board.helpers.maxValue {
+		return fmt.Errorf("Invalid value %d, valid values are 1 to %d", value, g.board.helpers.maxValue)
+	}
+	return g.play(Move{Kind: MoveMark, Position: p, Value: value})
+}
+
+// Undo undoes the last applied move, returns false when there is none
+func (g *Game) Undo() bool {
+	return g.GoTo(g.current-1) == nil
+}
+
+// Redo applies the next undone move, returns false when there is none
+func (g *Game) Redo() bool {
+	return g.GoTo(g.current+1) == nil
+}
+
+// GoTo undoes or redoes moves until the first n moves of the history are
+// applied, 0 is the puzzle
+func (g *Game) GoTo(n int) error {
+	if n < 0 || n > len(g.history) {
+		return fmt.Errorf("Invalid history point %d, valid points are 0 to %d", n, len(g.history))
+	}
+	for ; g.current > n; g.current-- {
+		g.undo(g.history[g.current-1])
+	}
+	for ; g.current < n; g.current++ {
+		g.apply(g.history[g.current].Move)
+	}
+	return nil
+}
+
+// Moves returns every move of the history, the first Current ones are applied
+func (g *Game) Moves() []Move {
+	moves := make([]Move, len(g.history))
+	for num, m := range g.history {
+		moves[num] = m.Move
+	}
+	return moves
+}
+
+// Elapsed returns the playing time, the time before the game was decoded
+// included
+func (g *Game) Elapsed() time.Duration {
+	return g.played + g.now().Sub(g.start)
+}
+
+// SetClock replaces the clock measuring the playing time, time.Now by
+// default, keeping the time played
+func (g *Game) SetClock(now func() time.Time) {
+	g.played, g.now = g.Elapsed(), now
+	g.start = g.now()
+}
+
+// Current returns the number of applied moves
+func (g *Game) Current() int {
+	return g.current
+}
+
+// IsSolved returns if every cell is filled following the rules
+func (g *Game) IsSolved() bool {
+	return g.board.isSolved() && g.board.IsValid()
+}
+
+// MarshalJSON encodes the game as its puzzle and history
+func (g *Game) MarshalJSON() ([]byte, error) {
+	e := gameJSON{Puzzle: g.puzzle, Moves: []moveJSON{}, Current: g.current, Elapsed: g.Elapsed()}
+	if g.mode != CheckConflicts {
+		e.Check = checkModes[g.mode]
+	}
+	for _, m := range g.history {
+		e.Moves = append(e.Moves, moveJSON{
+			Kind:  moveKinds[m.Kind],
+			Row:   m.Position.Row,
+			Col:   m.Position.Col,
+			Value: m.Value,
+		})
+	}
+	return json.Marshal(e)
+}
+
+// UnmarshalJSON decodes a game, replaying its history
+func (g *Game) UnmarshalJSON(data []byte) error {
+	var e gameJSON
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	if e.Puzzle == nil || len(e.Puzzle.data) == 0 {
+		return fmt.Errorf("A valid game contains its puzzle")
+	}
+	res := NewGame(e.Puzzle)
+	if g.now != nil {
+		res.now = g.now
+	}
+	res.played, res.start = e.Elapsed, res.now()
+	for num, m := range e.Moves {
+		kind := MoveKind(-1)
+		for k, name := range moveKinds {
+			if name == m.Kind {
+				kind = MoveKind(k)
+			}
+		}
+		if kind < 0 {
+			return fmt.Errorf("Move %d: Unknown move kind %q", num, m.Kind)
+		}
+		move := Move{Kind: kind, Position: Position{m.Row, m.Col}, Value: m.Value}
+		var err error
+		switch kind {
+		case MoveSet:
+			err = res.Set(move.Position, move.Value)
+		case MoveClear:
+			err = res.Clear(move.Position)
+		case MoveMark:
+			err = res.ToggleMark(move.Position, move.Value)
+		}
+		if err != nil {
+			return fmt.Errorf("Move %d: %v", num, err)
+		}
+	}
+	if err := res.GoTo(e.Current); err != nil {
+		return err
+	}
+	if e.Check != "" {
+		mode := CheckMode(-1)
+		for m, name := range checkModes {
+			if name == e.Check {
+				mode = CheckMode(m)
+			}
+		}
+		if mode < 0 {
+			return fmt.Errorf("Unknown check mode %q", e.Check)
+		}
+		if err := res.SetCheckMode(mode); err != nil {
+			return err
+		}
+	}
+	*g = *res
+	return nil
+}
+
+// play applies a new move, the undone moves are dropped from the history
+func (g *Game) play(m Move) error {
+	if !g.board.inBoard(m.Position) {
+		return fmt.Errorf("Cell %v is out of the board", m.Position)
+	}
+	pos := g.board.getPos(m.Position)
+	if g.board.data[pos].given {
+		return fmt.Errorf("Cell %v is a given", m.Position)
+	}
+	if (m.Kind == MoveSet && g.board.getValue(pos) == m.Value) || (m.Kind == MoveClear && g.board.getValue(pos) == 0) {
+		return nil
+	}
+	g.history = append(g.history[:g.current], gameMove{Move: m, previous: g.board.getValue(pos)})
+	g.apply(m)
+	g.current++
+	return nil
+}
+
+// apply applies a move to the board
+func (g *Game) apply(m Move) {
+	pos := g.board.getPos(m.Position)
+	switch m.Kind {
+	case MoveSet:
+		g.board.setValue(pos, m.Value)
+	case MoveClear:
+		g.board.clearValue(pos)
+	case MoveMark:
+		g.toggleMark(pos, m.Value)
+	}
+}
+
+// undo restores the board before a move
+func (g *Game) undo(m gameMove) {
+	pos := g.board.getPos(m.Position)
+	switch {
+	case m.Kind == MoveMark:
+		g.toggleMark(pos, m.Value)
+	case m.previous == 0:
+		g.board.clearValue(pos)
+	default:
+		g.board.setValue(pos, m.previous)
+	}
+}
+
+// toggleMark adds or removes a pencil mark, keeping them in order
+func (g *Game) toggleMark(pos int, value int) {
+	marks := []int{}
+	for _, mark := range g.marks[pos] {
+		if mark != value {
+			marks = append(marks, mark)
+		}
+	}
+	if len(marks) == len(g.marks[pos]) {
+		marks = append(marks, value)
+		sort.Ints(marks)
+	}
+	g.marks[pos] = marks
+}
@@ -0,0 +1,123 @@
+package sodogo
+
+import (
+	"fmt"
+)
+
+// CheckMode how the game moves are checked
+type CheckMode int
+
+// Move check modes
+const (
+	CheckConflicts CheckMode = iota // values breaking the IsValid rules with their peers, the default
+	CheckStrict                     // values differing from the puzzle solution
+)
+
+// checkModes check modes JSON names
+var checkModes = []string{"conflicts", "strict"}
+
+// Conflicts the wrong cells of a game and their units
+type Conflicts struct {
+	Cells []Position // wrong cells, in board order
+	Units []Unit     // units to highlight: boxes, rows, columns and groups
+}
+
+// SetCheckMode sets how the moves are checked, CheckStrict solves the puzzle
+// the first time
+func (g *Game) SetCheckMode(mode CheckMode) error {
+	if mode != CheckConflicts && mode != CheckStrict {
+		return fmt.Errorf("Unknown check mode %d", mode)
+	}
+	if mode == CheckStrict && g.solution == nil {
+		test := g.puzzle.Clone()
+		if !test.Solve() {
+			return fmt.Errorf("Strict checking needs a puzzle the solver can finish")
+		}
+		g.solution = make([]int, len(test.data))
+		for pos := range test.data {
+			g.solution[pos] = test.getValue(pos)
+		}
+	}
+	g.mode = mode
+	return nil
+}
+
+// CheckMode returns how the moves are checked
+func (g *Game) CheckMode() CheckMode {
+	return g.mode
+}
+
+// IsWrong returns if the value of a cell is wrong. CheckConflicts only checks
+// the peers of the cell in its units, its parity and the markers and outside
+// clues covering it, not the whole board.
+func (g *Game) IsWrong(p Position) bool {
+	if !g.board.inBoard(p) {
+		return false
+	}
+	return g.isWrong(g.board.getPos(p))
+}
+
+// Conflicts returns the wrong cells and the units to highlight. CheckConflicts
+// lists the units repeating a value, a cell breaking only its parity, a marker
+// or an outside clue has no unit. CheckStrict lists every unit with a wrong
+// cell, the solution is the rule of all of them.
+func (g *Game) Conflicts() (res Conflicts) {
+	res.Cells, res.Units = []Position{}, []Unit{}
+	if g.mode == CheckConflicts {
+		e := g.board.validate()
+		wrong := g.board.conflictCells(e)
+		for pos := range g.board.data {
+			if wrong[pos] {
+				res.Cells = append(res.Cells, g.board.helpers.position(pos))
+			}
+		}
+		for _, d := range e.Duplicates {
+			if last := len(res.Units) - 1; last < 0 || res.Units[last].Kind != d.Unit.Kind || res.Units[last].Index != d.Unit.Index {
+				res.Units = append(res.Units, d.Unit)
+			}
+		}
+		return res
+	}
+
+	for pos := range g.board.data {
+		if g.isWrong(pos) {
+			res.Cells = append(res.Cells, g.board.helpers.position(pos))
+		}
+	}
+	for num, unit := range g.units {
+		for _, pos := range unit {
+			if g.isWrong(pos) {
+				res.Units = append(res.Units, g.board.helpers.newUnit(num, unit))
+				break
+			}
+		}
+	}
+	return res
+}
+
+// isWrong returns if the value of a cell is wrong
+func (g *Game) isWrong(pos int) bool {
+	value := g.board.getValue(pos)
+	if value == 0 {
+		return false
+	}
+	if g.mode == CheckStrict {
+		return value != g.solution[pos]
+	}
+	if !g.board.data[pos].parity.allows(value) {
+		return true
+	}
+	for _, num := range g.unitsOf[pos] {
+		for _, peer := range g.units[num] {
+			if peer != pos && g.board.getValue(peer) == value {
+				return true
+			}
+		}
+	}
+	for _, c := range g.board.constraints {
+		if c.breaks(g.board, pos) {
+			return true
+		}
+	}
+	return false
+}
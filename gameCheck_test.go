@@ -0,0 +1,168 @@
+package sodogo
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestGame_Conflicts(t *testing.T) {
+	tests := []struct {
+		name      string
+		mode      CheckMode
+		moves     map[Position]int
+		want      Conflicts
+		wantWrong []Position
+	}{
+		{
+			name:  "no moves",
+			want:  Conflicts{Cells: []Position{}, Units: []Unit{}},
+			moves: map[Position]int{},
+		},
+		{
+			name:  "conflicts, right values",
+			moves: map[Position]int{{0, 1}: 2, {1, 1}: 4},
+			want:  Conflicts{Cells: []Position{}, Units: []Unit{}},
+		},
+		{
+			name:  "conflicts, a repeated value",
+			moves: map[Position]int{{0, 1}: 3},
+			want: Conflicts{
+				Cells: []Position{{0, 1}, {0, 2}, {1, 0}},
+				Units: []Unit{
+					{Kind: UnitBox, Index: 0, Cells: []Position{{0, 0}, {0, 1}, {1, 0}, {1, 1}}},
+					{Kind: UnitRow, Index: 0, Cells: []Position{{0, 0}, {0, 1}, {0, 2}, {0, 3}}},
+				},
+			},
+			wantWrong: []Position{{0, 1}, {0, 2}, {1, 0}},
+		},
+		{
+			name:  "conflicts, a wrong value without peers",
+			moves: map[Position]int{{0, 1}: 4},
+			want:  Conflicts{Cells: []Position{}, Units: []Unit{}},
+		},
+		{
+			name:  "strict, a wrong value without peers",
+			mode:  CheckStrict,
+			moves: map[Position]int{{0, 1}: 4, {1, 1}: 4},
+			want: Conflicts{
+				Cells: []Position{{0, 1}},
+				Units: []Unit{
+					{Kind: UnitBox, Index: 0, Cells: []Position{{0, 0}, {0, 1}, {1, 0}, {1, 1}}},
+					{Kind: UnitRow, Index: 0, Cells: []Position{{0, 0}, {0, 1}, {0, 2}, {0, 3}}},
+					{Kind: UnitColumn, Index: 1, Cells: []Position{{0, 1}, {1, 1}, {2, 1}, {3, 1}}},
+				},
+			},
+			wantWrong: []Position{{0, 1}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := testGame()
+			if err := g.SetCheckMode(tt.mode); err != nil {
+				t.Fatal(err)
+			}
+			for p, value := range tt.moves {
+				if err := g.Set(p, value); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if res := g.Conflicts(); !reflect.DeepEqual(res, tt.want) {
+				t.Errorf("Game.Conflicts() res = %+v, want %+v", res, tt.want)
+			}
+			wrong := []Position{}
+			for pos := 0; pos < 16; pos++ {
+				if g.IsWrong(Position{pos / 4, pos % 4}) {
+					wrong = append(wrong, Position{pos / 4, pos % 4})
+				}
+			}
+			if len(tt.wantWrong) == 0 {
+				tt.wantWrong = []Position{}
+			}
+			if !reflect.DeepEqual(wrong, tt.wantWrong) {
+				t.Errorf("Game.IsWrong() cells = %v, want %v", wrong, tt.wantWrong)
+			}
+		})
+	}
+}
+
+func TestGame_Conflicts_rules(t *testing.T) {
+	b := NewBoard(NewHelperBoard(2).WithHyper())
+	_ = b.LoadFromString("0000010000000000")
+	_ = b.LoadParityFromString("o...............")
+	_ = b.AddMarkers(GreaterThan{Position{3, 2}, Position{3, 3}})
+	g := NewGame(b)
+	_ = g.Set(Position{0, 0}, 2) // odd cell
+	_ = g.Set(Position{2, 2}, 1) // same hyper window
+	_ = g.Set(Position{3, 2}, 2)
+	_ = g.Set(Position{3, 3}, 3) // breaks the marker
+
+	want := Conflicts{
+		Cells: []Position{{0, 0}, {1, 1}, {2, 2}, {3, 2}, {3, 3}},
+		Units: []Unit{{Kind: UnitGroup, Index: 0, Cells: []Position{{1, 1}, {1, 2}, {2, 1}, {2, 2}}}},
+	}
+	if res := g.Conflicts(); !reflect.DeepEqual(res, want) {
+		t.Errorf("Game.Conflicts() res = %+v, want %+v", res, want)
+	}
+	for _, p := range want.Cells {
+		if !g.IsWrong(p) {
+			t.Errorf("Game.IsWrong(%v) = false, want true", p)
+		}
+	}
+}
+
+func TestGame_IsWrong_rules(t *testing.T) {
+	b := NewBoard(NewHelperBoard(2))
+	_ = b.LoadFromString("0000000000000000")
+	_ = b.LoadParityFromString("..e.............")
+	_ = b.AddMarkers(XV{Position{0, 0}, Position{0, 1}, V})
+	b.AddXVNegative()
+	_ = b.AddClues(Sandwich{Top, 3, 2}, LittleKiller{Left, 0, DownRight, 9})
+	g := NewGame(b)
+	_ = g.Set(Position{0, 0}, 1)
+	_ = g.Set(Position{0, 1}, 3) // breaks the V
+	_ = g.Set(Position{0, 2}, 3) // odd in an even cell, repeats the 3
+	_ = g.Set(Position{1, 0}, 4) // no marker, adds up to V
+	_ = g.Set(Position{1, 3}, 2)
+	_ = g.Set(Position{2, 3}, 4) // 4 and 1 sandwich nothing, not 2
+	_ = g.Set(Position{3, 3}, 1)
+	_ = g.Set(Position{3, 2}, 2)
+
+	// checking a cell finds the same conflicts as checking the whole board
+	conflicts := g.board.getConflicts()
+	wrong := 0
+	for pos := range g.board.data {
+		p := g.board.helpers.position(pos)
+		if res := g.IsWrong(p); res != conflicts[pos] {
+			t.Errorf("Game.IsWrong(%v) = %v, want %v", p, res, conflicts[pos])
+		}
+		if conflicts[pos] {
+			wrong++
+		}
+	}
+	if wrong == 0 {
+		t.Errorf("Game.IsWrong() no wrong cells")
+	}
+}
+
+func TestGame_SetCheckMode(t *testing.T) {
+	g := testGame()
+	if err := g.SetCheckMode(CheckMode(2)); err == nil || err.Error() != "Unknown check mode 2" {
+		t.Errorf("Game.SetCheckMode() err = %v", err)
+	}
+	if err := g.SetCheckMode(CheckStrict); err != nil || g.CheckMode() != CheckStrict {
+		t.Errorf("Game.SetCheckMode() err = %v, mode %v", err, g.CheckMode())
+	}
+
+	impossible := NewGame(test3x3BoardImpossible())
+	if err := impossible.SetCheckMode(CheckStrict); err == nil || impossible.CheckMode() != CheckConflicts {
+		t.Errorf("Game.SetCheckMode() err = %v, mode %v", err, impossible.CheckMode())
+	}
+
+	// the check mode is kept by the JSON encoding
+	data, _ := json.Marshal(g)
+	var res Game
+	if err := json.Unmarshal(data, &res); err != nil || res.CheckMode() != CheckStrict {
+		t.Errorf("Game.UnmarshalJSON() err = %v, mode %v", err, res.CheckMode())
+	}
+}
@@ -0,0 +1,244 @@
+package sodogo
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// testGame returns a game of the 1.3.3..2.1.34.2. 2x2 puzzle
+func testGame() *Game {
+	b := NewBoard(NewHelperBoard(2))
+	_ = b.LoadFromText("1.3.3..2.1.34.2.")
+	return NewGame(b)
+}
+
+func TestGame_moves(t *testing.T) {
+	tests := []struct {
+		name    string
+		play    func(g *Game) error
+		want    string
+		wantErr string
+	}{
+		{
+			name: "set",
+			play: func(g *Game) error { return g.Set(Position{0, 1}, 2) },
+			want: "1230300201034020",
+		},
+		{
+			name: "set again",
+			play: func(g *Game) error {
+				_ = g.Set(Position{0, 1}, 4)
+				return g.Set(Position{0, 1}, 2)
+			},
+			want: "1230300201034020",
+		},
+		{
+			name: "clear",
+			play: func(g *Game) error {
+				_ = g.Set(Position{0, 1}, 2)
+				return g.Clear(Position{0, 1})
+			},
+			want: "1030300201034020",
+		},
+		{
+			name:    "given",
+			play:    func(g *Game) error { return g.Set(Position{0, 0}, 2) },
+			want:    "1030300201034020",
+			wantErr: "Cell r1c1 is a given",
+		},
+		{
+			name:    "clear given",
+			play:    func(g *Game) error { return g.Clear(Position{1, 0}) },
+			want:    "1030300201034020",
+			wantErr: "Cell r2c1 is a given",
+		},
+		{
+			name:    "out of the board",
+			play:    func(g *Game) error { return g.Set(Position{4, 0}, 2) },
+			want:    "1030300201034020",
+			wantErr: "Cell r5c1 is out of the board",
+		},
+		{
+			name:    "invalid value",
+			play:    func(g *Game) error { return g.Set(Position{0, 1}, 5) },
+			want:    "1030300201034020",
+			wantErr: "Invalid value 5, valid values are 1 to 4",
+		},
+		{
+			name:    "invalid mark",
+			play:    func(g *Game) error { return g.ToggleMark(Position{0, 1}, 0) },
+			want:    "1030300201034020",
+			wantErr: "Invalid value 0, valid values are 1 to 4",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := testGame()
+			err := tt.play(g)
+			if (err != nil || tt.wantErr != "") && (err == nil || err.Error() != tt.wantErr) {
+				t.Errorf("Game move err = %v, want %v", err, tt.wantErr)
+			}
+			b := g.Board()
+			if res := b.String(); res != tt.want {
+				t.Errorf("Game.Board() res = %v, want %v", res, tt.want)
+			}
+		})
+	}
+}
+
+func TestGame_history(t *testing.T) {
+	g := testGame()
+	_ = g.Set(Position{0, 1}, 4)
+	_ = g.Set(Position{0, 1}, 2)
+	_ = g.ToggleMark(Position{0, 3}, 4)
+	_ = g.ToggleMark(Position{0, 3}, 1)
+	_ = g.Clear(Position{0, 1})
+	_ = g.Set(Position{0, 1}, 2) // a no-op move is not recorded
+
+	boards := []string{
+		"1030300201034020",
+		"1430300201034020",
+		"1230300201034020",
+		"1230300201034020",
+		"1230300201034020",
+		"1030300201034020",
+		"1230300201034020",
+	}
+	marks := [][]int{{}, {}, {}, {4}, {1, 4}, {1, 4}, {1, 4}}
+	if len(g.Moves()) != 6 || g.Current() != 6 {
+		t.Fatalf("Game.Moves() = %v, current %v, want 6 moves", g.Moves(), g.Current())
+	}
+	for _, n := range []int{6, 3, 0, 5, 1, 4, 2} {
+		if err := g.GoTo(n); err != nil {
+			t.Fatalf("Game.GoTo(%d) err = %v", n, err)
+		}
+		b := g.Board()
+		if res := b.String(); res != boards[n] || g.Current() != n {
+			t.Errorf("Game.GoTo(%d) board = %v, current %v, want %v", n, res, g.Current(), boards[n])
+		}
+		if res := g.Marks(Position{0, 3}); !reflect.DeepEqual(res, marks[n]) {
+			t.Errorf("Game.GoTo(%d) marks = %v, want %v", n, res, marks[n])
+		}
+	}
+	if err := g.GoTo(7); err == nil || err.Error() != "Invalid history point 7, valid points are 0 to 6" {
+		t.Errorf("Game.GoTo(7) err = %v", err)
+	}
+
+	// undo, redo and a new move dropping the undone ones
+	if !g.Undo() || !g.Undo() || g.Current() != 0 || g.Undo() {
+		t.Errorf("Game.Undo() current = %v, want 0", g.Current())
+	}
+	if !g.Redo() || g.Current() != 1 {
+		t.Errorf("Game.Redo() current = %v, want 1", g.Current())
+	}
+	_ = g.Set(Position{0, 3}, 2)
+	want := []Move{{MoveSet, Position{0, 1}, 4}, {MoveSet, Position{0, 3}, 2}}
+	if res := g.Moves(); !reflect.DeepEqual(res, want) || g.Redo() {
+		t.Errorf("Game.Moves() = %v, want %v", res, want)
+	}
+}
+
+func TestGame_IsSolved(t *testing.T) {
+	g := testGame()
+	solution := "1234341221434321"
+	for pos := range solution {
+		_ = g.Set(Position{pos / 4, pos % 4}, int(solution[pos]-'0'))
+	}
+	if !g.IsSolved() {
+		t.Errorf("Game.IsSolved() = false, want true")
+	}
+	_ = g.Set(Position{0, 1}, 4)
+	if g.IsSolved() {
+		t.Errorf("Game.IsSolved() with a conflict = true, want false")
+	}
+}
+
+func TestGame_Elapsed(t *testing.T) {
+	clock := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	g := testGame()
+	g.SetClock(func() time.Time { return clock })
+	clock = clock.Add(time.Minute)
+	if res := g.Elapsed().Round(time.Second); res != time.Minute {
+		t.Errorf("Game.Elapsed() = %v, want 1m0s", res)
+	}
+
+	data, _ := json.Marshal(g)
+	clock = clock.Add(time.Hour)
+	res := &Game{now: func() time.Time { return clock }}
+	if err := json.Unmarshal(data, res); err != nil {
+		t.Fatalf("Game.UnmarshalJSON() err = %v", err)
+	}
+	clock = clock.Add(30 * time.Second)
+	if elapsed := res.Elapsed().Round(time.Second); elapsed != 90*time.Second {
+		t.Errorf("Game.Elapsed() after decoding = %v, want 1m30s", elapsed)
+	}
+}
+
+func TestGame_JSON(t *testing.T) {
+	g := testGame()
+	_ = g.Set(Position{0, 1}, 2)
+	_ = g.ToggleMark(Position{0, 3}, 4)
+	_ = g.Clear(Position{0, 1})
+	g.Undo()
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	g.played, g.start, g.now = 90*time.Second, start, func() time.Time { return start.Add(5 * time.Second) }
+
+	data, err := json.Marshal(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"puzzle":{"size":2,"givens":"1030300201034020","values":"1030300201034020"},"moves":[{"kind":"set","row":0,"col":1,"value":2},{"kind":"mark","row":0,"col":3,"value":4},{"kind":"clear","row":0,"col":1}],"current":2,"elapsed":95000000000}`
+	if string(data) != want {
+		t.Errorf("Game.MarshalJSON() res = %s, want %s", data, want)
+	}
+
+	var res Game
+	if err := json.Unmarshal(data, &res); err != nil {
+		t.Fatalf("Game.UnmarshalJSON() err = %v", err)
+	}
+	b, resBoard := g.Board(), res.Board()
+	if resBoard.String() != b.String() || res.Current() != 2 || !reflect.DeepEqual(res.Moves(), g.Moves()) || !reflect.DeepEqual(res.Marks(Position{0, 3}), []int{4}) {
+		t.Errorf("Game.UnmarshalJSON() board = %v, moves %v", resBoard.String(), res.Moves())
+	}
+	if !res.Redo() || res.Current() != 3 {
+		t.Errorf("Game.UnmarshalJSON() can not redo the undone moves")
+	}
+	if res.Elapsed() < 95*time.Second {
+		t.Errorf("Game.UnmarshalJSON() elapsed = %v, want from 95s", res.Elapsed())
+	}
+
+	// puzzles with variant rules keep them
+	rules := NewBoard(NewHelperBoard(2).WithHyper())
+	_ = rules.LoadFromString("1000000000000000")
+	_ = rules.LoadParityFromString(".o..............")
+	_ = rules.AddMarkers(XV{Position{1, 0}, Position{1, 1}, V})
+	_ = rules.AddClues(Sandwich{Top, 3, 5})
+	g = NewGame(rules)
+	_ = g.Set(Position{0, 1}, 3)
+	if data, err = json.Marshal(g); err != nil {
+		t.Fatalf("Game.MarshalJSON() rules err = %v", err)
+	}
+	res = Game{}
+	if err := json.Unmarshal(data, &res); err != nil || !res.Board().Equal(g.Board()) || !res.puzzle.Equal(rules) {
+		t.Errorf("Game.UnmarshalJSON(%s) rules err = %v", data, err)
+	}
+
+	errors := []struct {
+		data string
+		want string
+	}{
+		{`{"moves":[]}`, "A valid game contains its puzzle"},
+		{`{"puzzle":{"size":1000},"moves":[]}`, "Invalid board size 1000, valid sizes are 1 to 7"},
+		{`{"puzzle":{"size":2,"givens":"10303002"},"moves":[]}`, "A valid board definition contains 16 caracters, not 8"},
+		{`{"puzzle":{"size":2,"givens":"1030300201034020","values":"1030300201034020"},"moves":[{"kind":"jump"}]}`, `Move 0: Unknown move kind "jump"`},
+		{`{"puzzle":{"size":2,"givens":"1030300201034020","values":"1030300201034020"},"moves":[{"kind":"set","value":2}]}`, "Move 0: Cell r1c1 is a given"},
+		{`{"puzzle":{"size":2,"givens":"1030300201034020","values":"1030300201034020"},"moves":[],"current":1}`, "Invalid history point 1, valid points are 0 to 0"},
+	}
+	for _, tt := range errors {
+		if err := json.Unmarshal([]byte(tt.data), &res); err == nil || err.Error() != tt.want {
+			t.Errorf("Game.UnmarshalJSON(%s) err = %v, want %v", tt.data, err, tt.want)
+		}
+	}
+}
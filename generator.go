@@ -0,0 +1,102 @@
+package sodogo
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+)
+
+// Puzzle grades, by the solver passes needed
+const (
+	GradeEasy       = "easy"       // up to half the board values passes
+	GradeMedium     = "medium"     // up to the board values passes
+	GradeHard       = "hard"       // more passes
+	GradeUnsolvable = "unsolvable" // the solver can not finish it
+)
+
+// Generate returns a puzzle the solver can finish, removing the values of a
+// random solved board while it stays solvable. A puzzle solved by deduction
+// has a single solution. Boards with extra groups are not supported.
+func Generate(h HelperBoard, rng *rand.Rand) (b *Board, err error) {
+	return GenerateContext(context.Background(), h, rng)
+}
+
+// GenerateContext returns a puzzle like Generate, stops with the context
+// error when the context is done
+func GenerateContext(ctx context.Context, h HelperBoard, rng *rand.Rand) (b *Board, err error) {
+	if len(h.extraGroups) > 0 {
+		return nil, fmt.Errorf("Boards with extra groups can not be generated")
+	}
+	values := generateSolution(h, rng)
+
+	res := NewBoard(h)
+	for pos, value := range values {
+		res.setValue(pos, value)
+	}
+	for _, pos := range rng.Perm(h.boardSize) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		res.setValue(pos, 0)
+		res.setPotential(pos, potential{0})
+		test := res.Clone()
+		if !test.Solve() {
+			res.setValue(pos, values[pos])
+		}
+	}
+
+	b = NewBoard(h)
+	return b, b.LoadFromString(res.String())
+}
+
+// generateSolution returns the values of a random solved board, a pattern
+// with shuffled values, rows inside flats, flat rows, columns and flat columns
+func generateSolution(h HelperBoard, rng *rand.Rand) []int {
+	shuffled := func() (res []int) {
+		for _, group := range rng.Perm(h.flats) {
+			for _, inc := range rng.Perm(h.flats) {
+				res = append(res, group*h.flats+inc)
+			}
+		}
+		return res
+	}
+	rows, cols, symbols := shuffled(), shuffled(), rng.Perm(h.maxValue)
+
+	values := make([]int, h.boardSize)
+	for row := 0; row < h.maxValue; row++ {
+		for col := 0; col < h.maxValue; col++ {
+			r, c := rows[row], cols[col]
+			pattern := (h.flats*(r%h.flats) + r/h.flats + c) % h.maxValue
+			values[row*h.maxValue+col] = symbols[pattern] + 1
+		}
+	}
+	return values
+}
+
+// Grade returns the puzzle grade, by the solver passes needed to solve it
+func (b *Board) Grade() string {
+	test := b.Clone()
+	switch {
+	case !test.Solve():
+		return GradeUnsolvable
+	case test.Steps <= b.helpers.maxValue/2:
+		return GradeEasy
+	case test.Steps <= b.helpers.maxValue:
+		return GradeMedium
+	}
+	return GradeHard
+}
+
+// Hint returns the first cell filled by the solver in the next passes
+// filling a cell, and its value
+func (b *Board) Hint() (p Position, value int, ok bool) {
+	test := b.Clone()
+	for test.solveStep() != 0 {
+		for pos := 0; pos < b.helpers.boardSize; pos++ {
+			if b.getValue(pos) == 0 && test.getValue(pos) != 0 {
+				return Position{pos / b.helpers.maxValue, pos % b.helpers.maxValue}, test.getValue(pos), true
+			}
+		}
+	}
+	return p, 0, false
+}
@@ -0,0 +1,128 @@
+package sodogo
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	tests := []struct {
+		name    string
+		h       HelperBoard
+		wantErr bool
+	}{
+		{name: "2x2", h: NewHelperBoard(2)},
+		{name: "3x3", h: NewHelperBoard(3)},
+		{name: "2x2 hyper", h: NewHelperBoard(2).WithHyper(), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := Generate(tt.h, rand.New(rand.NewSource(1)))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Generate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			givens := 0
+			for pos := 0; pos < tt.h.boardSize; pos++ {
+				if b.getValue(pos) != 0 {
+					givens++
+					if !b.data[pos].given {
+						t.Errorf("Generate() value at %d is not a given", pos)
+					}
+				}
+			}
+			if givens == 0 || givens == tt.h.boardSize {
+				t.Errorf("Generate() givens = %v", givens)
+			}
+			solved := b.Clone()
+			if !solved.Solve() || !solved.IsValid() {
+				t.Errorf("Generate() res = %v, want a solvable puzzle", b.String())
+			}
+		})
+	}
+}
+
+func TestGenerateContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if b, err := GenerateContext(ctx, NewHelperBoard(5), rand.New(rand.NewSource(1))); b != nil || !errors.Is(err, context.Canceled) {
+		t.Errorf("GenerateContext() = %v %v, want context canceled", b, err)
+	}
+}
+
+func TestGenerate_seed(t *testing.T) {
+	first, _ := Generate(NewHelperBoard(3), rand.New(rand.NewSource(7)))
+	second, _ := Generate(NewHelperBoard(3), rand.New(rand.NewSource(7)))
+	if first.String() != second.String() {
+		t.Errorf("Generate() res = %v, want %v", second.String(), first.String())
+	}
+}
+
+func Test_generateSolution(t *testing.T) {
+	for seed := int64(0); seed < 10; seed++ {
+		h := NewHelperBoard(3)
+		b := NewBoard(h)
+		for pos, value := range generateSolution(h, rand.New(rand.NewSource(seed))) {
+			b.setValue(pos, value)
+		}
+		if !b.isSolved() || !b.IsValid() {
+			t.Errorf("generateSolution() res = %v, want a solved board", b.String())
+		}
+	}
+}
+
+func TestBoard_Grade(t *testing.T) {
+	hard, _ := Generate(NewHelperBoard(3), rand.New(rand.NewSource(3)))
+
+	tests := []struct {
+		name string
+		b    *Board
+		want string
+	}{
+		{name: "3x3 easy", b: test3x3BoardUnsolved(), want: GradeEasy},
+		{name: "3x3 hard", b: hard, want: GradeHard},
+		{name: "3x3 unsolvable", b: test3x3BoardImpossible(), want: GradeUnsolvable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if res := tt.b.Grade(); res != tt.want {
+				t.Errorf("Board.Grade() res = %v, want %v", res, tt.want)
+			}
+			if tt.b.isSolved() {
+				t.Errorf("Board.Grade() solved the board")
+			}
+		})
+	}
+}
+
+func TestBoard_Hint(t *testing.T) {
+	tests := []struct {
+		name      string
+		b         *Board
+		wantPos   Position
+		wantValue int
+		wantOk    bool
+	}{
+		{name: "3x3", b: test3x3BoardUnsolved(), wantPos: Position{0, 0}, wantValue: 8, wantOk: true},
+		{name: "2x2 solved", b: test2x2BoardSolved()},
+		{name: "3x3 impossible", b: test3x3BoardImpossible()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pos, value, ok := tt.b.Hint()
+			if pos != tt.wantPos || value != tt.wantValue || ok != tt.wantOk {
+				t.Errorf("Board.Hint() res = %v %v %v, want %v %v %v", pos, value, ok, tt.wantPos, tt.wantValue, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestPosition_String(t *testing.T) {
+	if res := (Position{Row: 0, Col: 8}).String(); res != "r1c9" {
+		t.Errorf("Position.String() res = %v, want r1c9", res)
+	}
+}
@@ -1,16 +1,27 @@
 package sodogo
 
+import (
+	"fmt"
+	"strings"
+)
+
+// symbols default alphabet, boards up to 9 values use digits and boards up to
+// 16 values continue with letters
+const symbols = "123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
 // HelperBoard a collection of helpers, Examples for a 3x3 soduku
 type HelperBoard struct {
-	flats            int    //  3
-	maxValue         int    //  9
-	boardSize        int    // 81
-	validValues      []int  // [1,2,3,4,5,6,7,8,9]
-	flatGroups       []int  // [0,0,0,3,3,3,6,6,6,0,0,0,3,3,3,6,6,6,0,0,0,3,3,3,6,6,6,27,27,27,30,30,30,33,...]
-	flatNeighbors    []int  // [0,1,2,9,10,11,18,19,20]
-	streetYNeighbors []int  // [0,1,2,3,4,5,6,7,8]
-	streetXNeighbors []int  // [0,9,18,27,36,45,54,63,72]
-	nicePrint        string // Table caracters
+	flats            int     //  3
+	maxValue         int     //  9
+	boardSize        int     // 81
+	validValues      []int   // [1,2,3,4,5,6,7,8,9]
+	flatGroups       []int   // [0,0,0,3,3,3,6,6,6,0,0,0,3,3,3,6,6,6,0,0,0,3,3,3,6,6,6,27,27,27,30,30,30,33,...]
+	flatNeighbors    []int   // [0,1,2,9,10,11,18,19,20]
+	streetYNeighbors []int   // [0,1,2,3,4,5,6,7,8]
+	streetXNeighbors []int   // [0,9,18,27,36,45,54,63,72]
+	extraGroups      [][]int // [[10,11,12,19,20,21,28,29,30],...] optional hyper or disjoint groups
+	alphabet         string  // "123456789" value symbols
+	nicePrint        string  // Table caracters
 }
 
 // NewHelperBoard create a the board helpers
@@ -27,10 +38,60 @@ func NewHelperBoard(size int) (h HelperBoard) {
 	h.flatNeighbors = h.generateFlatNeighbors()
 	h.streetYNeighbors = h.generateStreetYNeighbors()
 	h.streetXNeighbors = h.generateStreetXNeighbors()
+	h.alphabet = h.generateAlphabet()
 	h.nicePrint = h.generateNicePrint()
 	return h
 }
 
+// NewCheckedHelperBoard create a the board helpers, an error for sizes under
+// 1 or over 7, which need more symbols than the default alphabet has
+func NewCheckedHelperBoard(size int) (h HelperBoard, err error) {
+	if size < 1 {
+		return h, fmt.Errorf("Invalid size %d, the smallest size is 1", size)
+	}
+	if maxValue := size * size; maxValue > len(symbols) {
+		return h, fmt.Errorf("A board of size %d needs %d symbols, the default alphabet has %d", size, maxValue, len(symbols))
+	}
+	return NewHelperBoard(size), nil
+}
+
+// WithHyper returns the helpers with the hyper windows as extra groups
+func (h HelperBoard) WithHyper() HelperBoard {
+	h.extraGroups = append(h.extraGroups[:len(h.extraGroups):len(h.extraGroups)], h.generateHyperGroups()...)
+	return h
+}
+
+// WithDisjointGroups returns the helpers with the disjoint groups, the same
+// position in every flat, as extra groups
+func (h HelperBoard) WithDisjointGroups() HelperBoard {
+	h.extraGroups = append(h.extraGroups[:len(h.extraGroups):len(h.extraGroups)], h.generateDisjointGroups()...)
+	return h
+}
+
+// WithAlphabet returns the helpers using a symbol per value, like
+// "ABCDEFGHIJKLMNOPQRSTUVWXY" for 5x5 boards. '0' and '.' are empty cells.
+func (h HelperBoard) WithAlphabet(alphabet string) (HelperBoard, error) {
+	if len(alphabet) != h.maxValue {
+		return h, fmt.Errorf("A valid alphabet contains %d symbols, not %d", h.maxValue, len(alphabet))
+	}
+	for pos := 0; pos < len(alphabet); pos++ {
+		symbol := alphabet[pos]
+		if symbol <= ' ' || symbol > '~' || symbol == '0' || symbol == '.' {
+			return h, fmt.Errorf("Invalid symbol %q at position %d", symbol, pos)
+		}
+		if strings.IndexByte(alphabet, symbol) != pos {
+			return h, fmt.Errorf("Duplicated symbol %q at position %d", symbol, pos)
+		}
+	}
+	h.alphabet = alphabet
+	return h, nil
+}
+
+// Alphabet returns the value symbols, the symbol of value 1 first
+func (h HelperBoard) Alphabet() string {
+	return h.alphabet
+}
+
 func (h HelperBoard) generateValidValues() (res []int) {
 	res = []int{}
 	for pos := 0; pos < h.maxValue; pos++ {
@@ -80,6 +141,100 @@ func (h HelperBoard) generateStreetXNeighbors() (n neighbors) {
 	return n
 }
 
+func (h HelperBoard) generateAlphabet() string {
+	if h.maxValue > 16 && h.maxValue <= 26 {
+		return symbols[9 : 9+h.maxValue]
+	}
+	if h.maxValue > len(symbols) {
+		return symbols
+	}
+	return symbols[:h.maxValue]
+}
+
+// getSymbol returns the symbol of a value, '0' for empty cells
+func (h HelperBoard) getSymbol(value int) byte {
+	if value < 1 || value > len(h.alphabet) {
+		return '0'
+	}
+	return h.alphabet[value-1]
+}
+
+// getSymbolValue returns the value of a symbol, 0 for unknown symbols
+func (h HelperBoard) getSymbolValue(symbol byte) int {
+	return strings.IndexByte(h.alphabet, symbol) + 1
+}
+
+func (h HelperBoard) generateHyperGroups() (groups [][]int) {
+	groups = [][]int{}
+	for y := 1; y+h.flats < h.maxValue; y += h.flats + 1 {
+		for x := 1; x+h.flats < h.maxValue; x += h.flats + 1 {
+			inc := (y * h.maxValue) + x
+			group := []int{}
+			for _, pos := range h.flatNeighbors {
+				group = append(group, pos+inc)
+			}
+			groups = append(groups, group)
+		}
+	}
+	return groups
+}
+
+func (h HelperBoard) generateDisjointGroups() (groups [][]int) {
+	groups = [][]int{}
+	for _, pos := range h.flatNeighbors {
+		group := []int{}
+		for inc := 0; inc < h.boardSize; inc++ {
+			if h.flatGroups[inc] == inc {
+				group = append(group, pos+inc)
+			}
+		}
+		groups = append(groups, group)
+	}
+	return groups
+}
+
+// getUnits returns the positions of every flat, row, column and extra group
+func (h HelperBoard) getUnits() (units [][]int) {
+	for inc := 0; inc < h.boardSize; inc++ {
+		if h.flatGroups[inc] != inc {
+			continue
+		}
+		unit := []int{}
+		for _, pos := range h.flatNeighbors {
+			unit = append(unit, pos+inc)
+		}
+		units = append(units, unit)
+	}
+	for num := 0; num < h.maxValue; num++ {
+		unit := []int{}
+		for _, pos := range h.streetYNeighbors {
+			unit = append(unit, pos+num*h.maxValue)
+		}
+		units = append(units, unit)
+	}
+	for num := 0; num < h.maxValue; num++ {
+		unit := []int{}
+		for _, pos := range h.streetXNeighbors {
+			unit = append(unit, pos+num)
+		}
+		units = append(units, unit)
+	}
+	return append(units, h.extraGroups...)
+}
+
+// getExtraGroups returns the extra groups containing a position
+func (h HelperBoard) getExtraGroups(p int) (groups [][]int) {
+	for _, group := range h.extraGroups {
+		for _, pos := range group {
+			if pos == p {
+				groups = append(groups, group)
+				break
+			}
+		}
+	}
+	return groups
+}
+
 func (n neighbors) getPotentialValues(validValues []int) (int, []int) {
 	res := []int{}
 	for num, vVal := range validValues {
@@ -127,8 +282,29 @@ func (p potential) getPotentialValues(myPotential potential) int {
 	return res[0]
 }
 
+// intersect returns the values also found on the potential, an unknown potential keeps them all
+func (p potential) intersect(values []int) (res []int) {
+	if p == nil || (len(p) == 1 && p[0] == 0) {
+		return values
+	}
+	res = []int{}
+	for _, value := range values {
+		for _, pVal := range p {
+			if pVal == value {
+				res = append(res, value)
+				break
+			}
+		}
+	}
+	return res
+}
+
 func (h HelperBoard) generateNicePrint() (res string) {
-	tableData := [25]string{"╔", "═══", "╤", "╦", "╗", "║", " %s ", "│", "║", "║", "╚", "═══", "╧", "╩", "╝", "╠", "═══", "╪", "╬", "╣", "╟", "───", "┼", "╫", "╢"}
+	return h.generateNicePrintTable(boxTable)
+}
+
+// generateNicePrintTable returns the NicePrint format of a table style
+func (h HelperBoard) generateNicePrintTable(tableData [25]string) (res string) {
 	hIndex := 0
 	inc := 0
 	doubleMaxValue := h.maxValue * 2
@@ -5,6 +5,50 @@ import (
 	"testing"
 )
 
+func TestNewCheckedHelperBoard(t *testing.T) {
+	tests := []struct {
+		name         string
+		size         int
+		wantAlphabet string
+		wantErr      string
+	}{
+		{
+			name:         "2x2",
+			size:         2,
+			wantAlphabet: "1234",
+		},
+		{
+			name:         "7x7",
+			size:         7,
+			wantAlphabet: symbols[:49],
+		},
+		{
+			name:    "8x8",
+			size:    8,
+			wantErr: "A board of size 8 needs 64 symbols, the default alphabet has 61",
+		},
+		{
+			name:    "zero",
+			size:    0,
+			wantErr: "Invalid size 0, the smallest size is 1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, err := NewCheckedHelperBoard(tt.size)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Errorf("NewCheckedHelperBoard() err = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil || h.Alphabet() != tt.wantAlphabet {
+				t.Errorf("NewCheckedHelperBoard() alphabet = %v err = %v, want %v", h.Alphabet(), err, tt.wantAlphabet)
+			}
+		})
+	}
+}
+
 func test3x3Board() (h HelperBoard) {
 	return HelperBoard{
 		flats:     3,
@@ -236,3 +280,190 @@ func Test_potential_getPotentialValues(t *testing.T) {
 		})
 	}
 }
+
+func Test_potential_intersect(t *testing.T) {
+	tests := []struct {
+		name   string
+		p      potential
+		values []int
+		want   []int
+	}{
+		{
+			name:   "unknown",
+			p:      potential{0},
+			values: []int{1, 2, 3},
+			want:   []int{1, 2, 3},
+		},
+		{
+			name:   "nil",
+			p:      nil,
+			values: []int{1, 2},
+			want:   []int{1, 2},
+		},
+		{
+			name:   "intersection",
+			p:      potential{2, 3, 4},
+			values: []int{1, 2, 3},
+			want:   []int{2, 3},
+		},
+		{
+			name:   "empty",
+			p:      potential{},
+			values: []int{1, 2, 3},
+			want:   []int{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.p.intersect(tt.values); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("potential.intersect() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBoard_generateHyperGroups(t *testing.T) {
+	tests := []struct {
+		name       string
+		h          HelperBoard
+		wantGroups [][]int
+	}{
+		{
+			name:       "3x3",
+			h:          NewHelperBoard(3),
+			wantGroups: [][]int{{10, 11, 12, 19, 20, 21, 28, 29, 30}, {14, 15, 16, 23, 24, 25, 32, 33, 34}, {46, 47, 48, 55, 56, 57, 64, 65, 66}, {50, 51, 52, 59, 60, 61, 68, 69, 70}},
+		},
+		{
+			name:       "2x2",
+			h:          NewHelperBoard(2),
+			wantGroups: [][]int{{5, 6, 9, 10}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if gotGroups := tt.h.generateHyperGroups(); !reflect.DeepEqual(gotGroups, tt.wantGroups) {
+				t.Errorf("Board.generateHyperGroups = %v, want %v", gotGroups, tt.wantGroups)
+			}
+		})
+	}
+}
+
+func TestBoard_generateDisjointGroups(t *testing.T) {
+	tests := []struct {
+		name       string
+		h          HelperBoard
+		wantGroups [][]int
+	}{
+		{
+			name:       "2x2",
+			h:          NewHelperBoard(2),
+			wantGroups: [][]int{{0, 2, 8, 10}, {1, 3, 9, 11}, {4, 6, 12, 14}, {5, 7, 13, 15}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if gotGroups := tt.h.generateDisjointGroups(); !reflect.DeepEqual(gotGroups, tt.wantGroups) {
+				t.Errorf("Board.generateDisjointGroups = %v, want %v", gotGroups, tt.wantGroups)
+			}
+		})
+	}
+}
+
+func TestBoard_WithHyper(t *testing.T) {
+	h := NewHelperBoard(2)
+	hyper := h.WithHyper()
+	both := hyper.WithDisjointGroups()
+	if len(h.extraGroups) != 0 || len(hyper.extraGroups) != 1 || len(both.extraGroups) != 5 {
+		t.Errorf("Board.WithHyper extra groups = %d %d %d, want 0 1 5", len(h.extraGroups), len(hyper.extraGroups), len(both.extraGroups))
+	}
+}
+
+func TestBoard_generateAlphabet(t *testing.T) {
+	tests := []struct {
+		name string
+		h    HelperBoard
+		want string
+	}{
+		{
+			name: "2x2",
+			h:    test2x2Board(),
+			want: "1234",
+		},
+		{
+			name: "3x3",
+			h:    test3x3Board(),
+			want: "123456789",
+		},
+		{
+			name: "4x4",
+			h:    HelperBoard{flats: 4, maxValue: 16, boardSize: 256},
+			want: "123456789ABCDEFG",
+		},
+		{
+			name: "5x5",
+			h:    HelperBoard{flats: 5, maxValue: 25, boardSize: 625},
+			want: "ABCDEFGHIJKLMNOPQRSTUVWXY",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.h.generateAlphabet(); got != tt.want {
+				t.Errorf("Board.generateAlphabet = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBoard_WithAlphabet(t *testing.T) {
+	tests := []struct {
+		name     string
+		alphabet string
+		wantErr  bool
+	}{
+		{
+			name:     "letters",
+			alphabet: "ABCD",
+			wantErr:  false,
+		},
+		{
+			name:     "wrong size",
+			alphabet: "ABC",
+			wantErr:  true,
+		},
+		{
+			name:     "duplicated symbol",
+			alphabet: "ABCA",
+			wantErr:  true,
+		},
+		{
+			name:     "empty cell symbol",
+			alphabet: "A.CD",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, err := NewHelperBoard(2).WithAlphabet(tt.alphabet)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Board.WithAlphabet() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && h.Alphabet() != tt.alphabet {
+				t.Errorf("Board.WithAlphabet() alphabet = %v, want %v", h.Alphabet(), tt.alphabet)
+			}
+		})
+	}
+}
+
+func TestBoard_getUnits(t *testing.T) {
+	h := NewHelperBoard(2).WithHyper()
+	units := h.getUnits()
+	want := [][]int{{0, 1, 4, 5}, {8, 9, 12, 13}, {0, 1, 2, 3}, {0, 4, 8, 12}, {5, 6, 9, 10}}
+	if len(units) != 13 {
+		t.Fatalf("Board.getUnits = %d units, want %d", len(units), 13)
+	}
+	for num, wantUnit := range want {
+		if gotUnit := units[[]int{0, 2, 4, 8, 12}[num]]; !reflect.DeepEqual(gotUnit, wantUnit) {
+			t.Errorf("Board.getUnits = %v, want %v", gotUnit, wantUnit)
+		}
+	}
+}
@@ -0,0 +1,270 @@
+package sodogo
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+)
+
+/*
+     Samurai layout by flats, * flats are shared by two boards
+
+  0 0 0 . 1 1 1
+  0 0 0 . 1 1 1
+  0 0 * 2 * 1 1
+  . . 2 2 2 . .
+  3 3 * 2 * 4 4
+  3 3 3 . 4 4 4
+  3 3 3 . 4 4 4
+
+*/
+
+// Origin top-left row and column of a board inside a MultiBoard
+type Origin struct {
+	Row int
+	Col int
+}
+
+// MultiBoard several boards sharing cells, like the samurai sudoku
+type MultiBoard struct {
+	Boards  []*Board      // boards, their shared cells are copied after every load and solve step
+	origins []Origin      // board positions on the canvas
+	helpers HelperBoard   // helpers of every board
+	width   int           // canvas columns
+	height  int           // canvas rows
+	canvas  [][]boardCell // board cells of every canvas position, nil when no board covers it
+	Steps   int           // 0 steps
+	Elapsed time.Duration // 0 elapsed time
+}
+
+// boardCell a board and a position on it
+type boardCell struct {
+	board int
+	pos   int
+}
+
+// junctions box-drawing characters indexed by arms (up=1, down=2, left=4, right=8)
+var junctions = [2][2][]rune{
+	{[]rune(" │││─┘┐┤─└┌├─┴┬┼"), []rune(" ║║║─╜╖╢─╙╓╟─╨╥╫")},
+	{[]rune(" │││═╛╕╡═╘╒╞═╧╤╪"), []rune(" ║║║═╝╗╣═╚╔╠═╩╦╬")},
+}
+
+// NewSamurai create the five boards samurai layout, boards of size 1 can not
+// be laid out
+func NewSamurai(h HelperBoard) (m MultiBoard, err error) {
+	center := h.maxValue - h.flats
+	corner := center * 2
+	return NewMultiBoard(h, []Origin{{0, 0}, {0, corner}, {center, center}, {corner, 0}, {corner, corner}})
+}
+
+// NewMultiBoard create a board on every origin. Origins can not be negative
+// or repeated and are aligned to the flats, so overlapping boards share whole
+// flats.
+func NewMultiBoard(h HelperBoard, origins []Origin) (m MultiBoard, err error) {
+	if len(origins) == 0 {
+		return m, fmt.Errorf("A multi board needs at least one origin")
+	}
+	for num, o := range origins {
+		if o.Row < 0 || o.Col < 0 {
+			return m, fmt.Errorf("Origin %d (row %d, column %d) is out of the canvas, rows and columns start at 0", num, o.Row, o.Col)
+		}
+		if o.Row%h.flats != 0 || o.Col%h.flats != 0 {
+			return m, fmt.Errorf("Origin %d (row %d, column %d) is not aligned to the flats of %d cells", num, o.Row, o.Col, h.flats)
+		}
+		for prev := 0; prev < num; prev++ {
+			if origins[prev] == o {
+				return m, fmt.Errorf("Origin %d (row %d, column %d) repeats origin %d", num, o.Row, o.Col, prev)
+			}
+		}
+	}
+
+	m = MultiBoard{
+		origins: origins,
+		helpers: h,
+		Steps:   0,
+		Elapsed: 0,
+	}
+	for _, o := range origins {
+		if o.Row+h.maxValue > m.height {
+			m.height = o.Row + h.maxValue
+		}
+		if o.Col+h.maxValue > m.width {
+			m.width = o.Col + h.maxValue
+		}
+	}
+
+	m.canvas = make([][]boardCell, m.width*m.height)
+	for num, o := range origins {
+		for pos := 0; pos < h.boardSize; pos++ {
+			c := m.canvasPos(o, pos)
+			m.canvas[c] = append(m.canvas[c], boardCell{num, pos})
+		}
+		m.Boards = append(m.Boards, NewBoard(h))
+	}
+	return m, nil
+}
+
+// share copies the cells a board shares to the other boards
+func (m *MultiBoard) share(num int) {
+	b := m.Boards[num]
+	for pos := range b.data {
+		shared := m.canvas[m.canvasPos(m.origins[num], pos)]
+		if len(shared) < 2 {
+			continue
+		}
+		for _, other := range shared {
+			if other.board != num {
+				m.Boards[other.board].data[other.pos] = b.data[pos]
+			}
+		}
+	}
+}
+
+// LoadFromString converts a canvas string, row by row, to the boards. Positions
+// without cell are ignored.
+func (m *MultiBoard) LoadFromString(board string) error {
+	if len(board) != len(m.canvas) {
+		return fmt.Errorf("A valid board definition contains %d caracters, not %d", len(m.canvas), len(board))
+	}
+
+	for num, o := range m.origins {
+		var buffer bytes.Buffer
+		for pos := 0; pos < m.helpers.boardSize; pos++ {
+			buffer.WriteByte(board[m.canvasPos(o, pos)])
+		}
+		if err := m.Boards[num].LoadFromString(buffer.String()); err != nil {
+			return err
+		}
+		m.share(num)
+	}
+	return nil
+}
+
+// Solve the boards, every step runs over all of them so shared cells propagate
+func (m *MultiBoard) Solve() bool {
+	start := time.Now()
+	step := 1
+	for !m.isSolved() {
+		stepChanges := 0
+		for num := range m.Boards {
+			stepChanges += m.Boards[num].solveStep()
+			m.share(num)
+		}
+		if stepChanges == 0 {
+			break
+		}
+		step++
+	}
+	m.Steps = step
+	m.Elapsed = time.Since(start)
+	return m.isSolved()
+}
+
+// String returns the canvas as string, positions without cell are spaces
+func (m *MultiBoard) String() string {
+	res := bytes.Repeat([]byte{' '}, len(m.canvas))
+	for num, o := range m.origins {
+		board := m.Boards[num].String()
+		for pos := 0; pos < m.helpers.boardSize; pos++ {
+			res[m.canvasPos(o, pos)] = board[pos]
+		}
+	}
+	return string(res)
+}
+
+// NicePrint print the boards human representation
+func (m *MultiBoard) NicePrint() string {
+	var buffer bytes.Buffer
+	values := m.String()
+	flats := m.helpers.flats
+
+	for y := 0; y <= m.height*2; y++ {
+		row := y / 2
+		for x := 0; x <= m.width*2; x++ {
+			col := x / 2
+			switch {
+			case y%2 == 1 && x%2 == 1:
+				value := " "
+				if m.hasCell(row, col) && values[row*m.width+col] != '0' {
+					value = values[row*m.width+col : row*m.width+col+1]
+				}
+				buffer.WriteString(" " + value + " ")
+			case y%2 == 1:
+				arms := 0
+				if m.hasCell(row, col-1) || m.hasCell(row, col) {
+					arms = 3
+				}
+				buffer.WriteRune(junctions[0][boolToInt(col%flats == 0)][arms])
+			case x%2 == 1:
+				line := "   "
+				if m.hasCell(row-1, col) || m.hasCell(row, col) {
+					line = "───"
+					if row%flats == 0 {
+						line = "═══"
+					}
+				}
+				buffer.WriteString(line)
+			default:
+				arms := 0
+				if m.hasCell(row-1, col-1) || m.hasCell(row-1, col) {
+					arms |= 1
+				}
+				if m.hasCell(row, col-1) || m.hasCell(row, col) {
+					arms |= 2
+				}
+				if m.hasCell(row-1, col-1) || m.hasCell(row, col-1) {
+					arms |= 4
+				}
+				if m.hasCell(row-1, col) || m.hasCell(row, col) {
+					arms |= 8
+				}
+				buffer.WriteRune(junctions[boolToInt(row%flats == 0)][boolToInt(col%flats == 0)][arms])
+			}
+		}
+		buffer.WriteString("\n")
+	}
+	return buffer.String()
+}
+
+// IsValid returns if every board is valid
+func (m *MultiBoard) IsValid() bool {
+	for num := range m.Boards {
+		if !m.Boards[num].IsValid() {
+			return false
+		}
+	}
+	return true
+}
+
+// isSolved returns if every board is solved
+func (m *MultiBoard) isSolved() bool {
+	for num := range m.Boards {
+		if !m.Boards[num].isSolved() {
+			return false
+		}
+	}
+	return true
+}
+
+// canvasPos returns the canvas position of a board position
+func (m *MultiBoard) canvasPos(o Origin, pos int) int {
+	row := o.Row + pos/m.helpers.maxValue
+	col := o.Col + pos%m.helpers.maxValue
+	return row*m.width + col
+}
+
+// hasCell returns if a canvas row and column holds a cell
+func (m *MultiBoard) hasCell(row int, col int) bool {
+	if row < 0 || col < 0 || row >= m.height || col >= m.width {
+		return false
+	}
+	return m.canvas[row*m.width+col] != nil
+}
+
+// boolToInt returns 1 when true
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
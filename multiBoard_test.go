@@ -0,0 +1,190 @@
+package sodogo
+
+import (
+	"testing"
+)
+
+// test2x2Samurai returns a 2x2 samurai canvas using the same solution on every board
+func test2x2Samurai() (m MultiBoard, solution string) {
+	m, _ = NewSamurai(NewHelperBoard(2))
+	res := []byte("1243124334213421431243122134213412431243342134214312431221342134")
+	solution = string(res)
+	for pos := range res {
+		if pos%3 == 0 {
+			res[pos] = '0'
+		}
+	}
+	_ = m.LoadFromString(string(res))
+	return m, solution
+}
+
+func TestMultiBoard_NewSamurai(t *testing.T) {
+	tests := []struct {
+		name       string
+		h          HelperBoard
+		wantWidth  int
+		wantHeight int
+		wantCells  int
+	}{
+		{
+			name:       "2x2",
+			h:          NewHelperBoard(2),
+			wantWidth:  8,
+			wantHeight: 8,
+			wantCells:  64,
+		},
+		{
+			name:       "3x3",
+			h:          NewHelperBoard(3),
+			wantWidth:  21,
+			wantHeight: 21,
+			wantCells:  369,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := NewSamurai(tt.h)
+			if err != nil {
+				t.Fatalf("NewSamurai() err = %v", err)
+			}
+			cells := 0
+			for _, c := range m.canvas {
+				if c != nil {
+					cells++
+				}
+			}
+			if m.width != tt.wantWidth || m.height != tt.wantHeight || cells != tt.wantCells {
+				t.Errorf("NewSamurai() = %dx%d %d cells, want %dx%d %d cells", m.width, m.height, cells, tt.wantWidth, tt.wantHeight, tt.wantCells)
+			}
+		})
+	}
+}
+
+func TestNewMultiBoard(t *testing.T) {
+	tests := []struct {
+		name    string
+		h       HelperBoard
+		origins []Origin
+		wantErr string
+	}{
+		{
+			name:    "overlapping flats",
+			h:       NewHelperBoard(2),
+			origins: []Origin{{0, 0}, {2, 2}},
+		},
+		{
+			name:    "no origins",
+			h:       NewHelperBoard(2),
+			wantErr: "A multi board needs at least one origin",
+		},
+		{
+			name:    "negative",
+			h:       NewHelperBoard(2),
+			origins: []Origin{{0, 0}, {-2, 2}},
+			wantErr: "Origin 1 (row -2, column 2) is out of the canvas, rows and columns start at 0",
+		},
+		{
+			name:    "not aligned",
+			h:       NewHelperBoard(2),
+			origins: []Origin{{0, 0}, {1, 3}},
+			wantErr: "Origin 1 (row 1, column 3) is not aligned to the flats of 2 cells",
+		},
+		{
+			name:    "repeated",
+			h:       NewHelperBoard(2),
+			origins: []Origin{{0, 0}, {2, 2}, {0, 0}},
+			wantErr: "Origin 2 (row 0, column 0) repeats origin 0",
+		},
+		{
+			name:    "samurai of size 1",
+			h:       NewHelperBoard(1),
+			origins: []Origin{{0, 0}, {0, 0}},
+			wantErr: "Origin 1 (row 0, column 0) repeats origin 0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := NewMultiBoard(tt.h, tt.origins)
+			if tt.wantErr == "" {
+				if err != nil || len(m.Boards) != len(tt.origins) {
+					t.Errorf("NewMultiBoard() boards = %v err = %v, want %v", len(m.Boards), err, len(tt.origins))
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("NewMultiBoard() err = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+	if _, err := NewSamurai(NewHelperBoard(1)); err == nil {
+		t.Errorf("NewSamurai() of size 1 err = nil, want an error")
+	}
+}
+
+func TestMultiBoard_sharedCells(t *testing.T) {
+	m, _ := NewSamurai(NewHelperBoard(2))
+	m.Boards[0].setValue(15, 4)
+	m.share(0)
+	if res := m.Boards[2].getValue(5); res != 4 {
+		t.Errorf("MultiBoard shared cell res = %v, wantRes %v", res, 4)
+	}
+}
+
+func TestMultiBoard_LoadFromString(t *testing.T) {
+	tests := []struct {
+		name    string
+		board   string
+		wantErr bool
+	}{
+		{
+			name:    "2x2 samurai",
+			board:   "1243124334213421431243122134213412431243342134214312431221342134",
+			wantErr: false,
+		},
+		{
+			name:    "2x2 samurai wrong size",
+			board:   "1243",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, _ := NewSamurai(NewHelperBoard(2))
+			if err := m.LoadFromString(tt.board); (err != nil) != tt.wantErr {
+				t.Errorf("MultiBoard.LoadFromString() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMultiBoard_Solve(t *testing.T) {
+	m, solution := test2x2Samurai()
+	if res := m.Solve(); !res {
+		t.Errorf("MultiBoard.Solve() res = %v, wantRes %v", res, true)
+	}
+	for pos := range solution {
+		if m.String()[pos] != solution[pos] {
+			t.Errorf("MultiBoard.String() res = %v, wantRes %v", m.String(), solution)
+			break
+		}
+	}
+	if res := m.IsValid(); !res {
+		t.Errorf("MultiBoard.IsValid() res = %v, wantRes %v", res, true)
+	}
+}
+
+func TestMultiBoard_NicePrint(t *testing.T) {
+	m, _ := NewMultiBoard(NewHelperBoard(2), []Origin{{0, 0}})
+	_ = m.LoadFromString("1234341221434321")
+	b := test2x2BoardSolved()
+	if res := m.NicePrint(); res != b.NicePrint() {
+		t.Errorf("MultiBoard.NicePrint() res = %v, res %v", res, b.NicePrint())
+	}
+
+	m, _ = NewMultiBoard(NewHelperBoard(2), []Origin{{0, 0}, {2, 2}})
+	_ = m.LoadFromString("1234  3412  214312432134  3421  1243")
+	want := "╔═══╤═══╦═══╤═══╗        \n║ 1 │ 2 ║ 3 │ 4 ║        \n╟───┼───╫───┼───╢        \n║ 3 │ 4 ║ 1 │ 2 ║        \n╠═══╪═══╬═══╪═══╬═══╤═══╗\n║ 2 │ 1 ║ 4 │ 3 ║ 1 │ 2 ║\n╟───┼───╫───┼───╫───┼───╢\n║ 4 │ 3 ║ 2 │ 1 ║ 3 │ 4 ║\n╚═══╧═══╬═══╪═══╬═══╪═══╣\n        ║ 3 │ 4 ║ 2 │ 1 ║\n        ╟───┼───╫───┼───╢\n        ║ 1 │ 2 ║ 4 │ 3 ║\n        ╚═══╧═══╩═══╧═══╝\n"
+	if res := m.NicePrint(); res != want {
+		t.Errorf("MultiBoard.NicePrint() res = %v, res %v", res, want)
+	}
+}
@@ -0,0 +1,429 @@
+package sodogo
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Side of the board where an outside clue is written
+type Side int
+
+// Board sides
+const (
+	Top Side = iota
+	Bottom
+	Left
+	Right
+)
+
+// Diagonal direction of a little killer clue
+type Diagonal int
+
+// Diagonal directions
+const (
+	DownRight Diagonal = iota
+	DownLeft
+	UpRight
+	UpLeft
+)
+
+var diagonalArrows = [4]string{"↘", "↙", "↗", "↖"}
+
+// OutsideClue a clue written outside the board
+type OutsideClue interface {
+	constraint
+	slot() (Side, int) // side and row or column where the clue is written
+	label() string     // clue representation
+	positions(h HelperBoard) []int
+}
+
+// Sandwich sum of the values between the lowest and the highest value of a
+// row (Left, Right) or a column (Top, Bottom)
+type Sandwich struct {
+	Side  Side
+	Index int
+	Sum   int
+}
+
+// LittleKiller sum of the values on the diagonal starting at a row (Left,
+// Right) or a column (Top, Bottom), values may repeat
+type LittleKiller struct {
+	Side      Side
+	Index     int
+	Direction Diagonal
+	Sum       int
+}
+
+// AddClues adds outside clues to the board
+func (b *Board) AddClues(clues ...OutsideClue) error {
+	for _, clue := range clues {
+		side, index := clue.slot()
+		if side < Top || side > Right {
+			return fmt.Errorf("Invalid clue side %d, valid sides are %d (Top) to %d (Right)", side, Top, Right)
+		}
+		if index < 0 || index >= b.helpers.maxValue {
+			return fmt.Errorf("Clue index %d out of range 0-%d", index, b.helpers.maxValue-1)
+		}
+		if k, ok := clue.(LittleKiller); ok && !k.pointsInside() {
+			return fmt.Errorf("Little killer clue at side %d points outside the board", k.Side)
+		}
+		if name, sum, low, high := clueSums(clue, b.helpers); sum < low || sum > high {
+			return fmt.Errorf("Invalid %s sum %d at side %d index %d, valid sums are %d to %d", name, sum, side, index, low, high)
+		}
+		for _, c := range b.getClues() {
+			if cSide, cIndex := c.slot(); cSide == side && cIndex == index {
+				return fmt.Errorf("Side %d index %d has already a clue", side, index)
+			}
+		}
+		b.constraints = append(b.constraints, clue)
+	}
+	return nil
+}
+
+// clueSums returns the clue name, its sum and the lowest and highest sums
+// its cells can add up to
+func clueSums(clue OutsideClue, h HelperBoard) (name string, sum int, low int, high int) {
+	switch c := clue.(type) {
+	case Sandwich:
+		// every value but the lowest and the highest one
+		return "sandwich", c.Sum, 0, h.maxValue*(h.maxValue+1)/2 - 1 - h.maxValue
+	case LittleKiller:
+		cells := len(c.positions(h))
+		return "little killer", c.Sum, cells, cells * h.maxValue
+	}
+	return "", 0, 0, 0
+}
+
+// getClues returns the board outside clues
+func (b *Board) getClues() (clues []OutsideClue) {
+	for _, c := range b.constraints {
+		if clue, ok := c.(OutsideClue); ok {
+			clues = append(clues, clue)
+		}
+	}
+	return clues
+}
+
+// addCluesBorder writes the outside clues around a NicePrint
+func (b *Board) addCluesBorder(nicePrint string) string {
+	var labels [4][]string
+	for side := range labels {
+		labels[side] = make([]string, b.helpers.maxValue)
+	}
+	for _, clue := range b.getClues() {
+		side, index := clue.slot()
+		labels[side][index] = clue.label()
+	}
+
+	var buffer bytes.Buffer
+	writeLine := func(side Side) {
+		buffer.WriteString("    ")
+		for _, label := range labels[side] {
+			buffer.WriteString(" " + padLabel(label, true))
+		}
+		buffer.WriteString("\n")
+	}
+
+	writeLine(Top)
+	lines := strings.Split(strings.TrimSuffix(nicePrint, "\n"), "\n")
+	for y, line := range lines {
+		left, right := "    ", ""
+		if y%2 == 1 {
+			left = padLabel(labels[Left][y/2], true) + " "
+			right = " " + padLabel(labels[Right][y/2], false)
+		}
+		buffer.WriteString(left + line + right + "\n")
+	}
+	writeLine(Bottom)
+	return buffer.String()
+}
+
+// padLabel pads a label to the three characters of a cell
+func padLabel(label string, alignRight bool) string {
+	padding := ""
+	if length := len([]rune(label)); length < 3 {
+		padding = strings.Repeat(" ", 3-length)
+	}
+	if alignRight {
+		return padding + label
+	}
+	return label + padding
+}
+
+func (s Sandwich) slot() (Side, int) {
+	return s.Side, s.Index
+}
+
+func (s Sandwich) label() string {
+	return strconv.Itoa(s.Sum)
+}
+
+// positions returns the line positions, streetY for rows and streetX for columns
+func (s Sandwich) positions(h HelperBoard) (res []int) {
+	inc, line := s.Index, h.streetXNeighbors
+	if s.Side == Left || s.Side == Right {
+		inc, line = s.Index*h.maxValue, h.streetYNeighbors
+	}
+	for _, pos := range line {
+		res = append(res, pos+inc)
+	}
+	return res
+}
+
+// prune keeps the values allowed by any placement of the lowest and the highest value
+func (s Sandwich) prune(b *Board) (changes int) {
+	line := s.positions(b.helpers)
+	low, high := b.helpers.validValues[0], b.helpers.maxValue
+	middle := b.helpers.validValues[1 : b.helpers.maxValue-1]
+
+	candidates := make([][]int, len(line))
+	allowed := make([][]int, len(line))
+	for num, pos := range line {
+		candidates[num] = b.getCandidates(pos)
+	}
+
+	for i := range line {
+		for j := i + 1; j < len(line); j++ {
+			for _, ends := range [][2]int{{low, high}, {high, low}} {
+				if !contains(candidates[i], ends[0]) || !contains(candidates[j], ends[1]) {
+					continue
+				}
+				between, ok := sandwichValues(candidates[i+1:j], middle, s.Sum)
+				if !ok {
+					continue
+				}
+				allowed[i] = append(allowed[i], ends[0])
+				allowed[j] = append(allowed[j], ends[1])
+				for num := range line {
+					if num > i && num < j {
+						allowed[num] = append(allowed[num], between[num-i-1]...)
+					} else if num != i && num != j {
+						for _, value := range candidates[num] {
+							if value != low && value != high {
+								allowed[num] = append(allowed[num], value)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+
+	for num, pos := range line {
+		changes += b.prunePotential(pos, allowed[num])
+	}
+	return changes
+}
+
+// sandwichValues returns the values every cell can take so that the cells add
+// up to sum with distinct middle values
+func sandwichValues(candidates [][]int, middle []int, sum int) (res [][]int, ok bool) {
+	count := len(candidates)
+	if count == 0 {
+		return res, sum == 0
+	}
+	for _, cellCandidates := range candidates {
+		values := []int{}
+		for _, value := range cellCandidates {
+			if !contains(middle, value) {
+				continue
+			}
+			others := []int{}
+			for _, m := range middle {
+				if m != value {
+					others = append(others, m)
+				}
+			}
+			if subsetSum(others, count-1, sum-value) {
+				values = append(values, value)
+			}
+		}
+		if len(values) == 0 {
+			return nil, false
+		}
+		res = append(res, values)
+	}
+	return res, true
+}
+
+// subsetSum returns if count distinct values add up to sum
+func subsetSum(values []int, count int, sum int) bool {
+	if count < 0 || sum < 0 {
+		return false
+	}
+	reach := make([][]bool, count+1)
+	for c := range reach {
+		reach[c] = make([]bool, sum+1)
+	}
+	reach[0][0] = true
+	for _, value := range values {
+		for c := count; c > 0; c-- {
+			for s := sum; s >= value; s-- {
+				if reach[c-1][s-value] {
+					reach[c][s] = true
+				}
+			}
+		}
+	}
+	return reach[count][sum]
+}
+
+// isValid checks the sum once the line is filled
+func (s Sandwich) isValid(b *Board) bool {
+	low, high := b.helpers.validValues[0], b.helpers.maxValue
+	sum, inside := 0, false
+	for _, pos := range s.positions(b.helpers) {
+		value := b.getValue(pos)
+		if value == 0 {
+			return true
+		}
+		if value == low || value == high {
+			inside = !inside
+			continue
+		}
+		if inside {
+			sum += value
+		}
+	}
+	return sum == s.Sum
+}
+
+func (s Sandwich) conflicts(b *Board) []int {
+	if s.isValid(b) {
+		return nil
+	}
+	return s.positions(b.helpers)
+}
+
+func (s Sandwich) breaks(b *Board, pos int) bool {
+	return contains(s.positions(b.helpers), pos) && !s.isValid(b)
+}
+
+func (k LittleKiller) slot() (Side, int) {
+	return k.Side, k.Index
+}
+
+func (k LittleKiller) label() string {
+	return strconv.Itoa(k.Sum) + diagonalArrows[k.Direction]
+}
+
+// pointsInside returns if the diagonal enters the board from its side
+func (k LittleKiller) pointsInside() bool {
+	switch k.Side {
+	case Top:
+		return k.Direction == DownRight || k.Direction == DownLeft
+	case Bottom:
+		return k.Direction == UpRight || k.Direction == UpLeft
+	case Left:
+		return k.Direction == DownRight || k.Direction == UpRight
+	case Right:
+		return k.Direction == DownLeft || k.Direction == UpLeft
+	}
+	return false
+}
+
+// positions returns the diagonal positions
+func (k LittleKiller) positions(h HelperBoard) (res []int) {
+	row, col := 0, k.Index
+	switch k.Side {
+	case Bottom:
+		row = h.maxValue - 1
+	case Left:
+		row, col = k.Index, 0
+	case Right:
+		row, col = k.Index, h.maxValue-1
+	}
+	rowStep, colStep := 1, 1
+	if k.Direction == UpRight || k.Direction == UpLeft {
+		rowStep = -1
+	}
+	if k.Direction == DownLeft || k.Direction == UpLeft {
+		colStep = -1
+	}
+	for ; row >= 0 && col >= 0 && row < h.maxValue && col < h.maxValue; row, col = row+rowStep, col+colStep {
+		res = append(res, row*h.maxValue+col)
+	}
+	return res
+}
+
+// prune keeps the values that can reach the sum with the other diagonal candidates
+func (k LittleKiller) prune(b *Board) (changes int) {
+	diagonal := k.positions(b.helpers)
+	candidates := make([][]int, len(diagonal))
+	for num, pos := range diagonal {
+		candidates[num] = b.getCandidates(pos)
+	}
+
+	// forward[num] sums reachable before num, backward[num] sums reachable from num
+	forward := make([][]bool, len(diagonal)+1)
+	backward := make([][]bool, len(diagonal)+1)
+	for num := range forward {
+		forward[num] = make([]bool, k.Sum+1)
+		backward[num] = make([]bool, k.Sum+1)
+	}
+	forward[0][0] = true
+	backward[len(diagonal)][0] = true
+	for num := range diagonal {
+		back := len(diagonal) - num - 1
+		for s := 0; s <= k.Sum; s++ {
+			for _, value := range candidates[num] {
+				if forward[num][s] && s+value <= k.Sum {
+					forward[num+1][s+value] = true
+				}
+			}
+			for _, value := range candidates[back] {
+				if backward[back+1][s] && s+value <= k.Sum {
+					backward[back][s+value] = true
+				}
+			}
+		}
+	}
+
+	for num, pos := range diagonal {
+		allowed := []int{}
+		for _, value := range candidates[num] {
+			for s := 0; s+value <= k.Sum; s++ {
+				if forward[num][s] && backward[num+1][k.Sum-s-value] {
+					allowed = append(allowed, value)
+					break
+				}
+			}
+		}
+		changes += b.prunePotential(pos, allowed)
+	}
+	return changes
+}
+
+// isValid checks the sum once the diagonal is filled
+func (k LittleKiller) isValid(b *Board) bool {
+	sum, filled := 0, true
+	for _, pos := range k.positions(b.helpers) {
+		value := b.getValue(pos)
+		if value == 0 {
+			filled = false
+		}
+		sum += value
+	}
+	if !filled {
+		return sum <= k.Sum
+	}
+	return sum == k.Sum
+}
+
+func (k LittleKiller) breaks(b *Board, pos int) bool {
+	return b.getValue(pos) != 0 && contains(k.positions(b.helpers), pos) && !k.isValid(b)
+}
+
+func (k LittleKiller) conflicts(b *Board) (res []int) {
+	if k.isValid(b) {
+		return nil
+	}
+	for _, pos := range k.positions(b.helpers) {
+		if b.getValue(pos) != 0 {
+			res = append(res, pos)
+		}
+	}
+	return res
+}
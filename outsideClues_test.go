@@ -0,0 +1,234 @@
+package sodogo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func test2x2BoardSandwich() (b *Board) {
+	helper := NewHelperBoard(2)
+	board := NewBoard(helper)
+	_ = board.LoadFromString("1200000000000000")
+	_ = board.AddClues(
+		Sandwich{Top, 0, 5}, Sandwich{Top, 1, 0}, Sandwich{Top, 2, 0}, Sandwich{Top, 3, 5},
+		Sandwich{Left, 0, 5}, Sandwich{Left, 1, 0}, Sandwich{Left, 2, 0}, Sandwich{Left, 3, 5},
+	)
+	return board
+}
+
+func TestBoard_AddClues(t *testing.T) {
+	tests := []struct {
+		name    string
+		clues   []OutsideClue
+		wantErr bool
+	}{
+		{
+			name:    "sandwich and little killer",
+			clues:   []OutsideClue{Sandwich{Top, 0, 5}, LittleKiller{Right, 0, DownLeft, 7}},
+			wantErr: false,
+		},
+		{
+			name:    "index out of range",
+			clues:   []OutsideClue{Sandwich{Left, 4, 5}},
+			wantErr: true,
+		},
+		{
+			name:    "little killer points outside",
+			clues:   []OutsideClue{LittleKiller{Top, 1, UpLeft, 3}},
+			wantErr: true,
+		},
+		{
+			name:    "side out of range",
+			clues:   []OutsideClue{Sandwich{Side(7), 0, 5}},
+			wantErr: true,
+		},
+		{
+			name:    "little killer side out of range",
+			clues:   []OutsideClue{LittleKiller{Side(-1), 0, DownRight, 5}},
+			wantErr: true,
+		},
+		{
+			name:    "negative little killer sum",
+			clues:   []OutsideClue{LittleKiller{Top, 0, DownRight, -3}},
+			wantErr: true,
+		},
+		{
+			name:    "little killer sum over its cells",
+			clues:   []OutsideClue{LittleKiller{Top, 2, DownRight, 9}},
+			wantErr: true,
+		},
+		{
+			name:    "little killer highest sum",
+			clues:   []OutsideClue{LittleKiller{Top, 2, DownRight, 8}},
+			wantErr: false,
+		},
+		{
+			name:    "sandwich sum over the middle values",
+			clues:   []OutsideClue{Sandwich{Left, 1, 6}},
+			wantErr: true,
+		},
+		{
+			name:    "negative sandwich sum",
+			clues:   []OutsideClue{Sandwich{Left, 1, -1}},
+			wantErr: true,
+		},
+		{
+			name:    "two clues on the same slot",
+			clues:   []OutsideClue{Sandwich{Top, 0, 5}, LittleKiller{Top, 0, DownRight, 10}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewBoard(NewHelperBoard(2))
+			if err := b.AddClues(tt.clues...); (err != nil) != tt.wantErr {
+				t.Errorf("Board.AddClues() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr {
+				b.Solve()
+				_ = b.NicePrint()
+			}
+		})
+	}
+}
+
+func TestOutsideClue_positions(t *testing.T) {
+	tests := []struct {
+		name string
+		clue OutsideClue
+		want []int
+	}{
+		{
+			name: "sandwich column",
+			clue: Sandwich{Top, 1, 0},
+			want: []int{1, 5, 9, 13},
+		},
+		{
+			name: "sandwich row",
+			clue: Sandwich{Right, 2, 0},
+			want: []int{8, 9, 10, 11},
+		},
+		{
+			name: "little killer",
+			clue: LittleKiller{Right, 0, DownLeft, 7},
+			want: []int{3, 6, 9, 12},
+		},
+		{
+			name: "little killer short diagonal",
+			clue: LittleKiller{Bottom, 1, UpLeft, 3},
+			want: []int{13, 8},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.clue.positions(NewHelperBoard(2)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("OutsideClue.positions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOutsideClue_prune(t *testing.T) {
+	tests := []struct {
+		name        string
+		clue        OutsideClue
+		wantChanges int
+		want        map[int][]int
+	}{
+		{
+			name:        "sandwich",
+			clue:        Sandwich{Left, 0, 5},
+			wantChanges: 4,
+			want:        map[int][]int{0: {1, 4}, 1: {2, 3}, 2: {2, 3}, 3: {1, 4}},
+		},
+		{
+			name:        "sandwich no values between",
+			clue:        Sandwich{Left, 0, 0},
+			wantChanges: 0,
+		},
+		{
+			name:        "little killer",
+			clue:        LittleKiller{Top, 1, DownRight, 3},
+			wantChanges: 3,
+			want:        map[int][]int{1: {1}, 6: {1}, 11: {1}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewBoard(NewHelperBoard(2))
+			_ = b.LoadFromString("0000000000000000")
+			if changes := tt.clue.prune(b); changes != tt.wantChanges {
+				t.Errorf("OutsideClue.prune() = %v, want %v", changes, tt.wantChanges)
+			}
+			for pos, want := range tt.want {
+				if got := b.getPotential(pos); !reflect.DeepEqual(got, want) {
+					t.Errorf("OutsideClue.prune() potential %d = %v, want %v", pos, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestOutsideClue_isValid(t *testing.T) {
+	tests := []struct {
+		name          string
+		clue          OutsideClue
+		want          bool
+		wantConflicts []int
+	}{
+		{
+			name: "sandwich",
+			clue: Sandwich{Top, 0, 5},
+			want: true,
+		},
+		{
+			name:          "wrong sandwich",
+			clue:          Sandwich{Top, 1, 5},
+			want:          false,
+			wantConflicts: []int{1, 5, 9, 13},
+		},
+		{
+			name: "little killer",
+			clue: LittleKiller{Left, 0, DownRight, 10},
+			want: true,
+		},
+		{
+			name:          "wrong little killer",
+			clue:          LittleKiller{Left, 0, DownRight, 9},
+			want:          false,
+			wantConflicts: []int{0, 5, 10, 15},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := test2x2BoardSolved()
+			if got := tt.clue.isValid(b); got != tt.want {
+				t.Errorf("OutsideClue.isValid() = %v, want %v", got, tt.want)
+			}
+			if got := tt.clue.conflicts(b); !reflect.DeepEqual(got, tt.wantConflicts) {
+				t.Errorf("OutsideClue.conflicts() = %v, want %v", got, tt.wantConflicts)
+			}
+			for pos := range b.data {
+				if got := tt.clue.breaks(b, pos); got != contains(tt.wantConflicts, pos) {
+					t.Errorf("OutsideClue.breaks(%d) = %v, want %v", pos, got, !got)
+				}
+			}
+		})
+	}
+}
+
+func TestBoard_SolveSandwich(t *testing.T) {
+	b := test2x2BoardSandwich()
+	if res := b.Solve(); !res || b.String() != "1234341221434321" {
+		t.Errorf("Board.Solve() res = %v %v, wantRes %v %v", res, b.String(), true, "1234341221434321")
+	}
+}
+
+func TestBoard_NicePrintClues(t *testing.T) {
+	b := test2x2BoardSolved()
+	_ = b.AddClues(Sandwich{Top, 0, 5}, Sandwich{Left, 1, 0}, LittleKiller{Right, 0, DownLeft, 8})
+	want := "       5            \n    ╔═══╤═══╦═══╤═══╗\n    ║ 1 │ 2 ║ 3 │ 4 ║ 8↙ \n    ╟───┼───╫───┼───╢\n  0 ║ 3 │ 4 ║ 1 │ 2 ║    \n    ╠═══╪═══╬═══╪═══╣\n    ║ 2 │ 1 ║ 4 │ 3 ║    \n    ╟───┼───╫───┼───╢\n    ║ 4 │ 3 ║ 2 │ 1 ║    \n    ╚═══╧═══╩═══╧═══╝\n                    \n"
+	if res := b.NicePrint(); res != want {
+		t.Errorf("Board.NicePrint() res = %v, res %v", res, want)
+	}
+}
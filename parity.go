@@ -0,0 +1,107 @@
+package sodogo
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Parity restriction of a shaded cell
+type Parity int
+
+// Cell parities
+const (
+	AnyParity Parity = iota
+	Odd
+	Even
+)
+
+// LoadParityFromString converts a mask, as long as the board string, to the
+// cells parity: 'o' odd, 'e' even and '.', '-' or '0' without restriction
+func (b *Board) LoadParityFromString(mask string) error {
+	if len(mask) != b.helpers.boardSize {
+		return fmt.Errorf("A valid parity mask contains %d caracters, not %d", b.helpers.boardSize, len(mask))
+	}
+
+	parities := make([]Parity, len(mask))
+	for inc := 0; inc < len(mask); inc++ {
+		switch mask[inc] {
+		case 'o', 'O':
+			parities[inc] = Odd
+		case 'e', 'E':
+			parities[inc] = Even
+		case '.', '-', '0':
+			parities[inc] = AnyParity
+		default:
+			return fmt.Errorf("Unknown parity %q at position %d", mask[inc], inc)
+		}
+	}
+
+	for inc, parity := range parities {
+		b.data[inc].parity = parity
+		if value := b.getValue(inc); value == 0 {
+			b.setPotential(inc, []int{value})
+			if parity != AnyParity {
+				b.setPotential(inc, b.helpers.getParityValues(parity))
+			}
+		}
+	}
+	return nil
+}
+
+// parityString returns the cells parity as a LoadParityFromString mask
+func (b *Board) parityString() string {
+	mask := make([]byte, b.helpers.boardSize)
+	for pos := range mask {
+		mask[pos] = ".oe"[b.data[pos].parity]
+	}
+	return string(mask)
+}
+
+// allows returns if a value follows the parity
+func (p Parity) allows(value int) bool {
+	switch p {
+	case Odd:
+		return value%2 == 1
+	case Even:
+		return value%2 == 0
+	}
+	return true
+}
+
+// getParityValues returns the valid values following a parity
+func (h HelperBoard) getParityValues(p Parity) (res []int) {
+	res = []int{}
+	for _, value := range h.validValues {
+		if p.allows(value) {
+			res = append(res, value)
+		}
+	}
+	return res
+}
+
+// hasParity returns if any cell is parity restricted
+func (b *Board) hasParity() bool {
+	for pos := 0; pos < b.helpers.boardSize; pos++ {
+		if b.data[pos].parity != AnyParity {
+			return true
+		}
+	}
+	return false
+}
+
+// addParityShading surrounds odd cells with () and even cells with [] on a NicePrint
+func (b *Board) addParityShading(nicePrint string) string {
+	lines := strings.Split(nicePrint, "\n")
+	for pos := 0; pos < b.helpers.boardSize; pos++ {
+		shading := map[Parity][]rune{Odd: []rune("()"), Even: []rune("[]")}[b.data[pos].parity]
+		if shading == nil {
+			continue
+		}
+		row, col := pos/b.helpers.maxValue, pos%b.helpers.maxValue
+		line := []rune(lines[row*2+1])
+		line[col*4+1] = shading[0]
+		line[col*4+3] = shading[1]
+		lines[row*2+1] = string(line)
+	}
+	return strings.Join(lines, "\n")
+}
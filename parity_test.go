@@ -0,0 +1,105 @@
+package sodogo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func test2x2BoardParity() (b *Board) {
+	helper := NewHelperBoard(2)
+	board := NewBoard(helper)
+	_ = board.LoadFromString("1200000021000000")
+	_ = board.LoadParityFromString("oeoeoeoeeoeoeoeo")
+	return board
+}
+
+func TestBoard_LoadParityFromString(t *testing.T) {
+	tests := []struct {
+		name    string
+		mask    string
+		wantErr bool
+		want    map[int][]int
+	}{
+		{
+			name:    "2x2",
+			mask:    "o.e.-0OE........",
+			wantErr: false,
+			want:    map[int][]int{0: {1}, 1: {0}, 2: {2, 4}, 6: {1, 3}, 7: {2, 4}},
+		},
+		{
+			name:    "2x2 wrong character",
+			mask:    "o.x.............",
+			wantErr: true,
+		},
+		{
+			name:    "2x2 wrong size",
+			mask:    "o.e.",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewBoard(NewHelperBoard(2))
+			_ = b.LoadFromString("1000000000000000")
+			if err := b.LoadParityFromString(tt.mask); (err != nil) != tt.wantErr {
+				t.Errorf("Board.LoadParityFromString() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			for pos, want := range tt.want {
+				if got := b.getPotential(pos); !reflect.DeepEqual(got, want) {
+					t.Errorf("Board.LoadParityFromString() potential %d = %v, want %v", pos, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestBoard_LoadFromStringKeepsParity(t *testing.T) {
+	b := test2x2BoardParity()
+	_ = b.LoadFromString("0000000000000000")
+	if got := b.getPotential(1); !reflect.DeepEqual(got, []int{2, 4}) {
+		t.Errorf("Board.LoadFromString() potential = %v, want %v", got, []int{2, 4})
+	}
+}
+
+func TestBoard_SolveParity(t *testing.T) {
+	b := test2x2BoardParity()
+	if res := b.Solve(); !res || b.String() != "1234341221434321" {
+		t.Errorf("Board.Solve() res = %v %v, wantRes %v %v", res, b.String(), true, "1234341221434321")
+	}
+}
+
+func TestBoard_isValidParity(t *testing.T) {
+	tests := []struct {
+		name      string
+		mask      string
+		wantValid bool
+	}{
+		{
+			name:      "2x2",
+			mask:      "oeoeoeoeeoeoeoeo",
+			wantValid: true,
+		},
+		{
+			name:      "2x2 wrong parity",
+			mask:      "eeoeoeoeeoeoeoeo",
+			wantValid: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := test2x2BoardSolved()
+			_ = b.LoadParityFromString(tt.mask)
+			if res := b.IsValid(); res != tt.wantValid {
+				t.Errorf("Board.IsValid() res = %v, wantRes %v", res, tt.wantValid)
+			}
+		})
+	}
+}
+
+func TestBoard_NicePrintParity(t *testing.T) {
+	b := test2x2BoardParity()
+	want := "╔═══╤═══╦═══╤═══╗\n║(1)│[2]║( )│[ ]║\n╟───┼───╫───┼───╢\n║( )│[ ]║( )│[ ]║\n╠═══╪═══╬═══╪═══╣\n║[2]│(1)║[ ]│( )║\n╟───┼───╫───┼───╢\n║[ ]│( )║[ ]│( )║\n╚═══╧═══╩═══╧═══╝\n"
+	if res := b.NicePrint(); res != want {
+		t.Errorf("Board.NicePrint() res = %v, res %v", res, want)
+	}
+}
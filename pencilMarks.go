@@ -0,0 +1,252 @@
+package sodogo
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+/*
+     Pencil marks grid example, 2x2 board
+
+  +--------------+--------------+
+  | 1     {34}   | {34}   2     |
+  | {234} {234}  | {134}  {134} |
+  +--------------+--------------+
+  | {234} {1234} | {1234} {134} |
+  | {234} {1234} | {1234} {134} |
+  +--------------+--------------+
+
+*/
+
+// PencilMarks returns the board as a pencil marks grid, filled cells are
+// written as values and empty cells as their potential values between {}
+func (b *Board) PencilMarks() string {
+	tokens := make([]string, b.helpers.boardSize)
+	widths := make([]int, b.helpers.maxValue)
+	for pos := range tokens {
+		tokens[pos] = b.pencilMarkToken(pos)
+		if col := pos % b.helpers.maxValue; len(tokens[pos]) > widths[col] {
+			widths[col] = len(tokens[pos])
+		}
+	}
+
+	var rule bytes.Buffer
+	rule.WriteString("+")
+	for col := 0; col < b.helpers.maxValue; col += b.helpers.flats {
+		length := 1
+		for inc := 0; inc < b.helpers.flats; inc++ {
+			length += widths[col+inc] + 1
+		}
+		rule.WriteString(strings.Repeat("-", length) + "+")
+	}
+	rule.WriteString("\n")
+
+	var buffer bytes.Buffer
+	for pos, token := range tokens {
+		row, col := pos/b.helpers.maxValue, pos%b.helpers.maxValue
+		if col == 0 && row%b.helpers.flats == 0 {
+			buffer.Write(rule.Bytes())
+		}
+		if col%b.helpers.flats == 0 {
+			buffer.WriteString("|")
+		}
+		buffer.WriteString(" " + token + strings.Repeat(" ", widths[col]-len(token)))
+		if (col+1)%b.helpers.flats == 0 {
+			buffer.WriteString(" ")
+		}
+		if col == b.helpers.maxValue-1 {
+			buffer.WriteString("|\n")
+		}
+	}
+	buffer.Write(rule.Bytes())
+	return buffer.String()
+}
+
+// LoadFromPencilMarks converts a pencil marks grid to a board, restoring the
+// potential values of the empty cells
+func (b *Board) LoadFromPencilMarks(text string) error {
+	var tokens []string
+	for _, line := range strings.Split(text, "\n") {
+		if isRuleLine(line, b.helpers.maxValue) {
+			continue
+		}
+		tokens = append(tokens, splitPencilMarks(line)...)
+	}
+	if len(tokens) != b.helpers.boardSize {
+		return fmt.Errorf("A valid pencil marks grid contains %d cells, not %d", b.helpers.boardSize, len(tokens))
+	}
+
+	candidates := make([][]int, len(tokens))
+	for pos, token := range tokens {
+		if token == "0" || token == "." {
+			candidates[pos] = append([]int{0}, b.helpers.validValues...)
+			continue
+		}
+		braces := strings.HasPrefix(token, "{")
+		token = strings.Trim(token, "{}")
+		for inc := 0; inc < len(token); inc++ {
+			value := b.helpers.getSymbolValue(token[inc])
+			if value == 0 {
+				return b.newLoadError(pos, token[inc], "Unknown caracter")
+			}
+			candidates[pos] = append(candidates[pos], value)
+		}
+		if !braces && len(candidates[pos]) != 1 {
+			braces = true
+		}
+		if braces {
+			candidates[pos] = append([]int{0}, candidates[pos]...)
+		}
+	}
+	return b.loadCandidates(candidates)
+}
+
+// CandidatesString returns the board potential values as a string of 0 and 1,
+// a caracter per value and cell
+func (b *Board) CandidatesString() string {
+	var buffer bytes.Buffer
+	for pos := 0; pos < b.helpers.boardSize; pos++ {
+		values := b.getExportedCandidates(pos)
+		for _, value := range b.helpers.validValues {
+			if contains(values, value) {
+				buffer.WriteByte('1')
+			} else {
+				buffer.WriteByte('0')
+			}
+		}
+	}
+	return buffer.String()
+}
+
+// LoadFromCandidatesString converts a string of 0 and 1, a caracter per value
+// and cell, to a board. Cells with a single candidate are filled.
+func (b *Board) LoadFromCandidatesString(board string) error {
+	candidates, err := b.parseCandidatesString(board)
+	if err != nil {
+		return err
+	}
+	return b.loadCandidates(candidates)
+}
+
+// parseCandidatesString returns the candidates of every cell, a single value
+// for filled cells or a list starting with 0 for empty cells
+func (b *Board) parseCandidatesString(board string) ([][]int, error) {
+	if len(board) != b.helpers.boardSize*b.helpers.maxValue {
+		return nil, fmt.Errorf("A valid candidates string contains %d caracters, not %d", b.helpers.boardSize*b.helpers.maxValue, len(board))
+	}
+
+	candidates := make([][]int, b.helpers.boardSize)
+	for pos := range candidates {
+		values := []int{}
+		for num, value := range b.helpers.validValues {
+			inc := pos*b.helpers.maxValue + num
+			switch board[inc] {
+			case '1':
+				values = append(values, value)
+			case '0':
+			default:
+				return nil, b.newLoadError(pos, board[inc], "Unknown candidate caracter")
+			}
+		}
+		if len(values) != 1 {
+			values = append([]int{0}, values...)
+		}
+		candidates[pos] = values
+	}
+	return candidates, nil
+}
+
+// loadCandidates fills the board, a single value is a filled cell and a list
+// starting with 0 is the potential values of an empty cell
+func (b *Board) loadCandidates(candidates [][]int) error {
+	var buffer bytes.Buffer
+	for _, values := range candidates {
+		if values[0] == 0 {
+			buffer.WriteByte('0')
+			continue
+		}
+		buffer.WriteByte(b.helpers.getSymbol(values[0]))
+	}
+	if err := b.LoadFromString(buffer.String()); err != nil {
+		return err
+	}
+	b.setCandidates(candidates)
+	return nil
+}
+
+// setCandidates saves the potential values of the empty cells, a list
+// starting with 0, a full list is an unknown potential
+func (b *Board) setCandidates(candidates [][]int) {
+	for pos, values := range candidates {
+		if values[0] != 0 || b.getValue(pos) != 0 {
+			continue
+		}
+		values = values[1:]
+		if len(values) == b.helpers.maxValue {
+			values = []int{0}
+		}
+		b.setPotential(pos, values)
+	}
+}
+
+// getExportedCandidates returns the cell potential values, all of them when unknown
+func (b *Board) getExportedCandidates(pos int) []int {
+	if value := b.getValue(pos); value != 0 {
+		return []int{value}
+	}
+	values := b.getPotential(pos)
+	if values == nil || (len(values) == 1 && values[0] == 0) {
+		return b.helpers.validValues
+	}
+	return values
+}
+
+// pencilMarkToken returns the cell pencil marks representation
+func (b *Board) pencilMarkToken(pos int) string {
+	values := b.getExportedCandidates(pos)
+	if b.getValue(pos) != 0 {
+		return string(b.helpers.getSymbol(values[0]))
+	}
+	var buffer bytes.Buffer
+	buffer.WriteString("{")
+	for _, value := range values {
+		buffer.WriteByte(b.helpers.getSymbol(value))
+	}
+	buffer.WriteString("}")
+	return buffer.String()
+}
+
+// splitPencilMarks returns the cells of a pencil marks line
+func splitPencilMarks(line string) (tokens []string) {
+	var token bytes.Buffer
+	grouped := false
+	flush := func() {
+		if token.Len() > 0 {
+			tokens = append(tokens, token.String())
+			token.Reset()
+		}
+	}
+	for _, r := range line {
+		switch {
+		case r == '{':
+			flush()
+			grouped = true
+			token.WriteRune(r)
+		case r == '}':
+			token.WriteRune(r)
+			grouped = false
+			flush()
+		case grouped:
+			if r != ' ' && r != ',' {
+				token.WriteRune(r)
+			}
+		case r == ' ' || r == '\t' || r == '\r' || strings.ContainsRune(verticalSeparators, r):
+			flush()
+		default:
+			token.WriteRune(r)
+		}
+	}
+	flush()
+	return tokens
+}
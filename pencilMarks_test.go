@@ -0,0 +1,127 @@
+package sodogo
+
+import (
+	"reflect"
+	"testing"
+)
+
+// test2x2BoardMidSolve returns a board after a solving step
+func test2x2BoardMidSolve() (b *Board) {
+	b = NewBoard(NewHelperBoard(2))
+	_ = b.LoadFromString("1002000000000000")
+	b.solveStep()
+	return b
+}
+
+func TestBoard_PencilMarks(t *testing.T) {
+	b := test2x2BoardMidSolve()
+	want := "+--------------+--------------+\n| 1     {34}   | {34}   2     |\n| {234} {234}  | {134}  {134} |\n+--------------+--------------+\n| {234} {1234} | {1234} {134} |\n| {234} {1234} | {1234} {134} |\n+--------------+--------------+\n"
+	if res := b.PencilMarks(); res != want {
+		t.Errorf("Board.PencilMarks() res = %v, want %v", res, want)
+	}
+}
+
+func TestBoard_LoadFromPencilMarks(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		wantErr bool
+		want    map[int][]int
+	}{
+		{
+			name:    "2x2",
+			text:    "+--------------+--------------+\n| 1     {34}   | {34}   2     |\n| {234} {234}  | {134}  {134} |\n+--------------+--------------+\n| {234} {1234} | {1234} {134} |\n| {234} {1234} | {1234} {134} |\n+--------------+--------------+\n",
+			wantErr: false,
+			want:    map[int][]int{0: {1}, 1: {3, 4}, 3: {2}, 4: {2, 3, 4}, 13: {0}},
+		},
+		{
+			name:    "2x2 without braces",
+			text:    "1 34 34 2\n234 234 134 134\n. . . .\n0 {} {1234} {4}\n",
+			wantErr: false,
+			want:    map[int][]int{1: {3, 4}, 8: {0}, 12: {0}, 13: {}, 14: {0}, 15: {4}},
+		},
+		{
+			name:    "2x2 missing cells",
+			text:    "1 34 34 2\n",
+			wantErr: true,
+		},
+		{
+			name:    "2x2 unknown caracter",
+			text:    "1 34 34 2\n234 234 134 134\n. . . .\n0 {} {1239} {4}\n",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewBoard(NewHelperBoard(2))
+			if err := b.LoadFromPencilMarks(tt.text); (err != nil) != tt.wantErr {
+				t.Fatalf("Board.LoadFromPencilMarks() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			for pos, want := range tt.want {
+				if got := b.getPotential(pos); !reflect.DeepEqual(got, want) {
+					t.Errorf("Board.LoadFromPencilMarks() potential %d = %v, want %v", pos, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestBoard_CandidatesString(t *testing.T) {
+	b := test2x2BoardMidSolve()
+	want := "1000001100110100011101111011101101111111111110110111111111111011"
+	if res := b.CandidatesString(); res != want {
+		t.Errorf("Board.CandidatesString() res = %v, want %v", res, want)
+	}
+}
+
+func TestBoard_LoadFromCandidatesString(t *testing.T) {
+	tests := []struct {
+		name    string
+		board   string
+		wantErr bool
+	}{
+		{
+			name:    "2x2",
+			board:   "1000001100110100011101111011101101111111111110110111111111111011",
+			wantErr: false,
+		},
+		{
+			name:    "2x2 wrong size",
+			board:   "1000",
+			wantErr: true,
+		},
+		{
+			name:    "2x2 wrong caracter",
+			board:   "1000001100110100011101111011101101111111111110110111111111111012",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewBoard(NewHelperBoard(2))
+			if err := b.LoadFromCandidatesString(tt.board); (err != nil) != tt.wantErr {
+				t.Fatalf("Board.LoadFromCandidatesString() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && b.CandidatesString() != tt.board {
+				t.Errorf("Board.LoadFromCandidatesString() = %v, want %v", b.CandidatesString(), tt.board)
+			}
+		})
+	}
+}
+
+func TestBoard_PencilMarksRoundTrip(t *testing.T) {
+	b := test3x3BoardUnsolved()
+	b.solveStep()
+	res := NewBoard(NewHelperBoard(3))
+	if err := res.LoadFromPencilMarks(b.PencilMarks()); err != nil {
+		t.Fatalf("Board.LoadFromPencilMarks() error = %v", err)
+	}
+	for pos := 0; pos < 81; pos++ {
+		if !reflect.DeepEqual(res.getPotential(pos), b.getPotential(pos)) || res.getValue(pos) != b.getValue(pos) {
+			t.Errorf("Board.LoadFromPencilMarks() cell %d = %v %v, want %v %v", pos, res.getValue(pos), res.getPotential(pos), b.getValue(pos), b.getPotential(pos))
+		}
+	}
+	if res.Solve() != true || res.String() != "864371259325849761971265843436192587198657432257483916689734125713528694542916378" {
+		t.Errorf("Board.Solve() res = %v", res.String())
+	}
+}
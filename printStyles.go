@@ -0,0 +1,169 @@
+package sodogo
+
+import (
+	"bytes"
+	"sort"
+	"strings"
+)
+
+/*
+     NicePrint styles, 2x2 board
+
+  BoxStyle               ASCIIStyle             CompactStyle
+
+  ╔═══╤═══╦═══╤═══╗      +===+===+===+===+      1 .  3 4
+  ║ 1 │   ║ 3 │ 4 ║      | 1 |   | 3 | 4 |      3 4  1 2
+  ╟───┼───╫───┼───╢      +---+---+---+---+
+  ║ 3 │ 4 ║ 1 │ 2 ║      | 3 | 4 | 1 | 2 |      2 1  4 3
+  ╠═══╪═══╬═══╪═══╣      +===+===+===+===+      4 3  2 1
+  ...                    ...
+
+*/
+
+// PrintStyle NicePrint table style
+type PrintStyle int
+
+// NicePrint table styles
+const (
+	BoxStyle     PrintStyle = iota // box-drawing caracters, the default
+	ASCIIStyle                     // '+', '-', '=' and '|' caracters, for logs and terminals without Unicode
+	CompactStyle                   // values separated by spaces, without borders, parity, markers or clues
+)
+
+// ANSI color codes
+const (
+	ansiGiven    = "\x1b[1m"    // bold
+	ansiSolved   = "\x1b[34m"   // blue
+	ansiConflict = "\x1b[1;31m" // bold red
+	ansiReset    = "\x1b[0m"
+)
+
+// PrintOptions NicePrint options
+type PrintOptions struct {
+	Style  PrintStyle // BoxStyle, ASCIIStyle or CompactStyle
+	Colors bool       // ANSI colors, bold givens, blue filled values and red conflicts
+	Cursor *Position  // cell surrounded by '>' and '<', not for CompactStyle
+}
+
+// Table caracters, by row type: top, cells, bottom, flat separator and cell
+// separator. Every row type has the left border, the cell, the separator
+// inside a flat, the separator between flats and the right border.
+var (
+	boxTable   = [25]string{"╔", "═══", "╤", "╦", "╗", "║", " %s ", "│", "║", "║", "╚", "═══", "╧", "╩", "╝", "╠", "═══", "╪", "╬", "╣", "╟", "───", "┼", "╫", "╢"}
+	asciiTable = [25]string{"+", "===", "+", "+", "+", "|", " %s ", "|", "|", "|", "+", "===", "+", "+", "+", "+", "===", "+", "+", "+", "+", "---", "+", "+", "+"}
+)
+
+// NicePrintWith print the sudoku human representation with a style and colors
+func (b *Board) NicePrintWith(opts PrintOptions) string {
+	var res string
+	switch opts.Style {
+	case ASCIIStyle:
+		res = b.nicePrintTable(asciiTable)
+	case CompactStyle:
+		res = b.compactPrint()
+	default:
+		res = b.nicePrintTable(boxTable)
+	}
+	if opts.Cursor != nil && opts.Style != CompactStyle && b.inBoard(*opts.Cursor) {
+		res = b.addCursor(res, *opts.Cursor)
+	}
+	if opts.Colors {
+		res = b.addColors(res, opts.Style)
+	}
+	return res
+}
+
+// compactPrint returns the values separated by spaces, '.' for empty cells
+// and an empty line between flats
+func (b *Board) compactPrint() string {
+	var buffer bytes.Buffer
+	maxValue, flats := b.helpers.maxValue, b.helpers.flats
+	for pos := 0; pos < b.helpers.boardSize; pos++ {
+		row, col := pos/maxValue, pos%maxValue
+		if col == 0 && row != 0 && row%flats == 0 {
+			buffer.WriteString("\n")
+		}
+		if col != 0 {
+			buffer.WriteString(" ")
+			if col%flats == 0 {
+				buffer.WriteString(" ")
+			}
+		}
+		symbol := byte('.')
+		if value := b.getValue(pos); value != 0 {
+			symbol = b.helpers.getSymbol(value)
+		}
+		buffer.WriteByte(symbol)
+		if col == maxValue-1 {
+			buffer.WriteString("\n")
+		}
+	}
+	return buffer.String()
+}
+
+// addCursor surrounds a cell of a NicePrint with '>' and '<'
+func (b *Board) addCursor(nicePrint string, p Position) string {
+	lineOffset, colOffset := 0, 0
+	if len(b.getClues()) > 0 {
+		lineOffset, colOffset = 1, 4
+	}
+	lines := strings.Split(nicePrint, "\n")
+	line := []rune(lines[lineOffset+p.Row*2+1])
+	line[colOffset+p.Col*4+1] = '>'
+	line[colOffset+p.Col*4+3] = '<'
+	lines[lineOffset+p.Row*2+1] = string(line)
+	return strings.Join(lines, "\n")
+}
+
+// addColors surrounds the filled cells of a NicePrint with ANSI colors
+func (b *Board) addColors(nicePrint string, style PrintStyle) string {
+	maxValue, flats := b.helpers.maxValue, b.helpers.flats
+	lineOffset, colOffset := 0, 0
+	if style != CompactStyle && len(b.getClues()) > 0 {
+		lineOffset, colOffset = 1, 4
+	}
+	conflicts := b.getConflicts()
+
+	lines := strings.Split(nicePrint, "\n")
+	cells := map[int][]int{} // line, cell positions
+	for pos := 0; pos < b.helpers.boardSize; pos++ {
+		if b.getValue(pos) == 0 {
+			continue
+		}
+		row := pos / maxValue
+		line := lineOffset + row*2 + 1
+		if style == CompactStyle {
+			line = row + row/flats
+		}
+		cells[line] = append(cells[line], pos)
+	}
+	for line, positions := range cells {
+		runes := []rune(lines[line])
+		// from right to left, the codes do not move the next cells
+		sort.Sort(sort.Reverse(sort.IntSlice(positions)))
+		for _, pos := range positions {
+			col := pos % maxValue
+			index := colOffset + col*4 + 2
+			if style == CompactStyle {
+				index = col*2 + col/flats
+			}
+			code := ansiSolved
+			if b.data[pos].given {
+				code = ansiGiven
+			}
+			if conflicts[pos] {
+				code = ansiConflict
+			}
+			colored := []rune(code + string(runes[index]) + ansiReset)
+			runes = append(runes[:index], append(colored, runes[index+1:]...)...)
+		}
+		lines[line] = string(runes)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// getConflicts returns the filled cells repeating a value in a unit, not
+// following their parity or breaking a marker or outside clue
+func (b *Board) getConflicts() map[int]bool {
+	return b.conflictCells(b.validate())
+}
@@ -0,0 +1,171 @@
+package sodogo
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestBoard_NicePrintWith(t *testing.T) {
+	h, _ := NewHelperBoard(2).WithAlphabet("ABCD")
+	alphabet := NewBoard(h)
+	_ = alphabet.LoadFromString("A0CDCDAB00000000")
+	conflict := NewBoard(NewHelperBoard(2))
+	_ = conflict.LoadFromString("1000000000000000")
+	conflict.setValue(1, 1)
+
+	tests := []struct {
+		name string
+		b    *Board
+		opts PrintOptions
+		want string
+	}{
+		{
+			name: "2x2 box",
+			b:    test2x2BoardSolved(),
+			want: "╔═══╤═══╦═══╤═══╗\n║ 1 │ 2 ║ 3 │ 4 ║\n╟───┼───╫───┼───╢\n║ 3 │ 4 ║ 1 │ 2 ║\n╠═══╪═══╬═══╪═══╣\n║ 2 │ 1 ║ 4 │ 3 ║\n╟───┼───╫───┼───╢\n║ 4 │ 3 ║ 2 │ 1 ║\n╚═══╧═══╩═══╧═══╝\n",
+		},
+		{
+			name: "2x2 ASCII",
+			b:    test2x2BoardSolved(),
+			opts: PrintOptions{Style: ASCIIStyle},
+			want: "+===+===+===+===+\n| 1 | 2 | 3 | 4 |\n+---+---+---+---+\n| 3 | 4 | 1 | 2 |\n+===+===+===+===+\n| 2 | 1 | 4 | 3 |\n+---+---+---+---+\n| 4 | 3 | 2 | 1 |\n+===+===+===+===+\n",
+		},
+		{
+			name: "2x2 compact",
+			b:    test2x2BoardFilled(),
+			opts: PrintOptions{Style: CompactStyle},
+			want: "1 2  3 4\n. .  . .\n\n. .  . .\n. .  . .\n",
+		},
+		{
+			name: "2x2 compact alphabet",
+			b:    alphabet,
+			opts: PrintOptions{Style: CompactStyle},
+			want: "A .  C D\nC D  A B\n\n. .  . .\n. .  . .\n",
+		},
+		{
+			name: "2x2 compact colors",
+			b:    test2x2BoardFilled(),
+			opts: PrintOptions{Style: CompactStyle, Colors: true},
+			want: "\x1b[1m1\x1b[0m \x1b[34m2\x1b[0m  \x1b[34m3\x1b[0m \x1b[34m4\x1b[0m\n. .  . .\n\n. .  . .\n. .  . .\n",
+		},
+		{
+			name: "2x2 ASCII conflict",
+			b:    conflict,
+			opts: PrintOptions{Style: ASCIIStyle, Colors: true},
+			want: "+===+===+===+===+\n| \x1b[1;31m1\x1b[0m | \x1b[1;31m1\x1b[0m |   |   |\n+---+---+---+---+\n|   |   |   |   |\n+===+===+===+===+\n|   |   |   |   |\n+---+---+---+---+\n|   |   |   |   |\n+===+===+===+===+\n",
+		},
+		{
+			name: "2x2 ASCII cursor",
+			b:    test2x2BoardFilled(),
+			opts: PrintOptions{Style: ASCIIStyle, Cursor: &Position{1, 2}},
+			want: "+===+===+===+===+\n| 1 | 2 | 3 | 4 |\n+---+---+---+---+\n|   |   |> <|   |\n+===+===+===+===+\n|   |   |   |   |\n+---+---+---+---+\n|   |   |   |   |\n+===+===+===+===+\n",
+		},
+		{
+			name: "2x2 ASCII cursor colors",
+			b:    test2x2BoardFilled(),
+			opts: PrintOptions{Style: ASCIIStyle, Colors: true, Cursor: &Position{0, 1}},
+			want: "+===+===+===+===+\n| \x1b[1m1\x1b[0m |>\x1b[34m2\x1b[0m<| \x1b[34m3\x1b[0m | \x1b[34m4\x1b[0m |\n+---+---+---+---+\n|   |   |   |   |\n+===+===+===+===+\n|   |   |   |   |\n+---+---+---+---+\n|   |   |   |   |\n+===+===+===+===+\n",
+		},
+		{
+			name: "2x2 cursor with clues",
+			b:    test2x2BoardSandwich(),
+			opts: PrintOptions{Cursor: &Position{1, 1}},
+			want: "       5   0   0   5\n    ╔═══╤═══╦═══╤═══╗\n  5 ║ 1 │ 2 ║   │   ║    \n    ╟───┼───╫───┼───╢\n  0 ║   │> <║   │   ║    \n    ╠═══╪═══╬═══╪═══╣\n  0 ║   │   ║   │   ║    \n    ╟───┼───╫───┼───╢\n  5 ║   │   ║   │   ║    \n    ╚═══╧═══╩═══╧═══╝\n                    \n",
+		},
+		{
+			name: "2x2 compact cursor",
+			b:    test2x2BoardFilled(),
+			opts: PrintOptions{Style: CompactStyle, Cursor: &Position{0, 0}},
+			want: "1 2  3 4\n. .  . .\n\n. .  . .\n. .  . .\n",
+		},
+		{
+			name: "2x2 cursor out of the board",
+			b:    test2x2BoardSolved(),
+			opts: PrintOptions{Style: ASCIIStyle, Cursor: &Position{4, 0}},
+			want: "+===+===+===+===+\n| 1 | 2 | 3 | 4 |\n+---+---+---+---+\n| 3 | 4 | 1 | 2 |\n+===+===+===+===+\n| 2 | 1 | 4 | 3 |\n+---+---+---+---+\n| 4 | 3 | 2 | 1 |\n+===+===+===+===+\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if res := tt.b.NicePrintWith(tt.opts); res != tt.want {
+				t.Errorf("Board.NicePrintWith() res = %q, want %q", res, tt.want)
+			}
+		})
+	}
+}
+
+func TestBoard_NicePrintWith_decorations(t *testing.T) {
+	b := test2x2BoardSandwich()
+	plain := b.NicePrintWith(PrintOptions{})
+	colored := b.NicePrintWith(PrintOptions{Colors: true})
+	if res := strings.NewReplacer(ansiGiven, "", ansiSolved, "", ansiConflict, "", ansiReset, "").Replace(colored); res != plain {
+		t.Errorf("Board.NicePrintWith() uncolored res = %v, want %v", res, plain)
+	}
+	if strings.Count(colored, ansiReset) != len(b.String())-strings.Count(b.String(), "0") {
+		t.Errorf("Board.NicePrintWith() res = %q, want a color per filled cell", colored)
+	}
+}
+
+func TestBoard_NicePrintWith_loadFromText(t *testing.T) {
+	b := test2x2BoardFilled()
+	for _, style := range []PrintStyle{BoxStyle, ASCIIStyle, CompactStyle} {
+		res := NewBoard(NewHelperBoard(2))
+		if err := res.LoadFromText(b.NicePrintWith(PrintOptions{Style: style})); err != nil || res.String() != b.String() {
+			t.Errorf("Board.LoadFromText() style %d res = %v, err %v, want %v", style, res.String(), err, b.String())
+		}
+	}
+}
+
+func TestBoard_getConflicts(t *testing.T) {
+	parity := test2x2BoardParity()
+	parity.setValue(2, 4)
+	marker := test2x2BoardSolved()
+	_ = marker.AddMarkers(GreaterThan{Position{0, 0}, Position{0, 1}})
+
+	tests := []struct {
+		name  string
+		board string
+		b     *Board
+		want  map[int]bool
+	}{
+		{
+			name:  "2x2 valid",
+			board: "1234341221434321",
+			want:  map[int]bool{},
+		},
+		{
+			name:  "2x2 row and flat",
+			board: "1100000000000000",
+			want:  map[int]bool{0: true, 1: true},
+		},
+		{
+			name:  "2x2 column",
+			board: "1000000000001000",
+			want:  map[int]bool{0: true, 12: true},
+		},
+		{
+			name: "2x2 parity",
+			b:    parity,
+			want: map[int]bool{2: true},
+		},
+		{
+			name: "2x2 marker",
+			b:    marker,
+			want: map[int]bool{0: true, 1: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := tt.b
+			if b == nil {
+				res := NewBoard(NewHelperBoard(2))
+				_ = res.LoadFromString(tt.board)
+				b = res
+			}
+			if res := b.getConflicts(); !reflect.DeepEqual(res, tt.want) {
+				t.Errorf("Board.getConflicts() res = %v, want %v", res, tt.want)
+			}
+		})
+	}
+}
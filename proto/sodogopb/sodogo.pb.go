@@ -0,0 +1,770 @@
+// sodogo gRPC API, the messages follow the server package JSON API and the
+// SolveTrace steps of the library.
+//
+// The Go code in proto/sodogopb is generated from the module root with protoc,
+// protoc-gen-go and protoc-gen-go-grpc:
+//
+//   protoc -I proto \
+//          --go_out=. --go_opt=module=github.com/rfiestas/sodogo \
+//          --go-grpc_out=. --go-grpc_opt=module=github.com/rfiestas/sodogo \
+//          proto/sodogo.proto
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: sodogo.proto
+
+package sodogopb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Status of a result.
+type Status int32
+
+const (
+	Status_STATUS_UNSPECIFIED Status = 0
+	Status_STATUS_SOLVED      Status = 1
+	Status_STATUS_UNSOLVABLE  Status = 2
+	Status_STATUS_VALID       Status = 3
+	Status_STATUS_INVALID     Status = 4
+	Status_STATUS_GENERATED   Status = 5
+)
+
+// Enum value maps for Status.
+var (
+	Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "STATUS_SOLVED",
+		2: "STATUS_UNSOLVABLE",
+		3: "STATUS_VALID",
+		4: "STATUS_INVALID",
+		5: "STATUS_GENERATED",
+	}
+	Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"STATUS_SOLVED":      1,
+		"STATUS_UNSOLVABLE":  2,
+		"STATUS_VALID":       3,
+		"STATUS_INVALID":     4,
+		"STATUS_GENERATED":   5,
+	}
+)
+
+func (x Status) Enum() *Status {
+	p := new(Status)
+	*p = x
+	return p
+}
+
+func (x Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_sodogo_proto_enumTypes[0].Descriptor()
+}
+
+func (Status) Type() protoreflect.EnumType {
+	return &file_sodogo_proto_enumTypes[0]
+}
+
+func (x Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Status.Descriptor instead.
+func (Status) EnumDescriptor() ([]byte, []int) {
+	return file_sodogo_proto_rawDescGZIP(), []int{0}
+}
+
+// Technique used to fill a cell.
+type Technique int32
+
+const (
+	Technique_TECHNIQUE_UNSPECIFIED   Technique = 0
+	Technique_TECHNIQUE_NAKED_SINGLE  Technique = 1 // the only candidate of a cell
+	Technique_TECHNIQUE_HIDDEN_SINGLE Technique = 2 // the only cell of a unit with a candidate
+)
+
+// Enum value maps for Technique.
+var (
+	Technique_name = map[int32]string{
+		0: "TECHNIQUE_UNSPECIFIED",
+		1: "TECHNIQUE_NAKED_SINGLE",
+		2: "TECHNIQUE_HIDDEN_SINGLE",
+	}
+	Technique_value = map[string]int32{
+		"TECHNIQUE_UNSPECIFIED":   0,
+		"TECHNIQUE_NAKED_SINGLE":  1,
+		"TECHNIQUE_HIDDEN_SINGLE": 2,
+	}
+)
+
+func (x Technique) Enum() *Technique {
+	p := new(Technique)
+	*p = x
+	return p
+}
+
+func (x Technique) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Technique) Descriptor() protoreflect.EnumDescriptor {
+	return file_sodogo_proto_enumTypes[1].Descriptor()
+}
+
+func (Technique) Type() protoreflect.EnumType {
+	return &file_sodogo_proto_enumTypes[1]
+}
+
+func (x Technique) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Technique.Descriptor instead.
+func (Technique) EnumDescriptor() ([]byte, []int) {
+	return file_sodogo_proto_rawDescGZIP(), []int{1}
+}
+
+// Board a board as a line of symbols, '0' or '.' for empty cells.
+type Board struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Size          int32                  `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`        // box size, 3 for 9x9 boards
+	Values        string                 `protobuf:"bytes,2,opt,name=values,proto3" json:"values,omitempty"`     // a symbol per cell, row by row
+	Alphabet      string                 `protobuf:"bytes,3,opt,name=alphabet,proto3" json:"alphabet,omitempty"` // a symbol per value, the default alphabet when empty
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Board) Reset() {
+	*x = Board{}
+	mi := &file_sodogo_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Board) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Board) ProtoMessage() {}
+
+func (x *Board) ProtoReflect() protoreflect.Message {
+	mi := &file_sodogo_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Board.ProtoReflect.Descriptor instead.
+func (*Board) Descriptor() ([]byte, []int) {
+	return file_sodogo_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Board) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Board) GetValues() string {
+	if x != nil {
+		return x.Values
+	}
+	return ""
+}
+
+func (x *Board) GetAlphabet() string {
+	if x != nil {
+		return x.Alphabet
+	}
+	return ""
+}
+
+// SolveOptions board variants.
+type SolveOptions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hyper         bool                   `protobuf:"varint,1,opt,name=hyper,proto3" json:"hyper,omitempty"`       // hyper windows
+	Disjoint      bool                   `protobuf:"varint,2,opt,name=disjoint,proto3" json:"disjoint,omitempty"` // disjoint groups
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SolveOptions) Reset() {
+	*x = SolveOptions{}
+	mi := &file_sodogo_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SolveOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SolveOptions) ProtoMessage() {}
+
+func (x *SolveOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_sodogo_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SolveOptions.ProtoReflect.Descriptor instead.
+func (*SolveOptions) Descriptor() ([]byte, []int) {
+	return file_sodogo_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SolveOptions) GetHyper() bool {
+	if x != nil {
+		return x.Hyper
+	}
+	return false
+}
+
+func (x *SolveOptions) GetDisjoint() bool {
+	if x != nil {
+		return x.Disjoint
+	}
+	return false
+}
+
+type SolveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Board         *Board                 `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
+	Options       *SolveOptions          `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SolveRequest) Reset() {
+	*x = SolveRequest{}
+	mi := &file_sodogo_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SolveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SolveRequest) ProtoMessage() {}
+
+func (x *SolveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sodogo_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SolveRequest.ProtoReflect.Descriptor instead.
+func (*SolveRequest) Descriptor() ([]byte, []int) {
+	return file_sodogo_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SolveRequest) GetBoard() *Board {
+	if x != nil {
+		return x.Board
+	}
+	return nil
+}
+
+func (x *SolveRequest) GetOptions() *SolveOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type GenerateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Size          int32                  `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
+	Seed          int64                  `protobuf:"varint,2,opt,name=seed,proto3" json:"seed,omitempty"` // random seed, the current time when 0
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateRequest) Reset() {
+	*x = GenerateRequest{}
+	mi := &file_sodogo_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateRequest) ProtoMessage() {}
+
+func (x *GenerateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sodogo_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateRequest.ProtoReflect.Descriptor instead.
+func (*GenerateRequest) Descriptor() ([]byte, []int) {
+	return file_sodogo_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GenerateRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GenerateRequest) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+// Step a cell filled by the solver, the library TraceStep.
+type Step struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pass          int32                  `protobuf:"varint,1,opt,name=pass,proto3" json:"pass,omitempty"` // solver pass, from 1
+	Row           int32                  `protobuf:"varint,2,opt,name=row,proto3" json:"row,omitempty"`   // from 0
+	Col           int32                  `protobuf:"varint,3,opt,name=col,proto3" json:"col,omitempty"`   // from 0
+	Value         int32                  `protobuf:"varint,4,opt,name=value,proto3" json:"value,omitempty"`
+	Technique     Technique              `protobuf:"varint,5,opt,name=technique,proto3,enum=sodogo.v1.Technique" json:"technique,omitempty"`
+	Unit          string                 `protobuf:"bytes,6,opt,name=unit,proto3" json:"unit,omitempty"` // hidden single unit: box, row, column or group
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Step) Reset() {
+	*x = Step{}
+	mi := &file_sodogo_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Step) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Step) ProtoMessage() {}
+
+func (x *Step) ProtoReflect() protoreflect.Message {
+	mi := &file_sodogo_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Step.ProtoReflect.Descriptor instead.
+func (*Step) Descriptor() ([]byte, []int) {
+	return file_sodogo_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Step) GetPass() int32 {
+	if x != nil {
+		return x.Pass
+	}
+	return 0
+}
+
+func (x *Step) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *Step) GetCol() int32 {
+	if x != nil {
+		return x.Col
+	}
+	return 0
+}
+
+func (x *Step) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Step) GetTechnique() Technique {
+	if x != nil {
+		return x.Technique
+	}
+	return Technique_TECHNIQUE_UNSPECIFIED
+}
+
+func (x *Step) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+type SolveResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        Status                 `protobuf:"varint,1,opt,name=status,proto3,enum=sodogo.v1.Status" json:"status,omitempty"`
+	Board         *Board                 `protobuf:"bytes,2,opt,name=board,proto3" json:"board,omitempty"`                                    // solved, partial or generated board
+	Steps         int32                  `protobuf:"varint,3,opt,name=steps,proto3" json:"steps,omitempty"`                                   // solver passes
+	ElapsedNanos  int64                  `protobuf:"varint,4,opt,name=elapsed_nanos,json=elapsedNanos,proto3" json:"elapsed_nanos,omitempty"` // solver elapsed time
+	Grade         string                 `protobuf:"bytes,5,opt,name=grade,proto3" json:"grade,omitempty"`                                    // easy, medium, hard or unsolvable
+	Hint          *Step                  `protobuf:"bytes,6,opt,name=hint,proto3" json:"hint,omitempty"`                                      // next cell the solver fills
+	Trace         []*Step                `protobuf:"bytes,7,rep,name=trace,proto3" json:"trace,omitempty"`                                    // filled cells, in order
+	Error         string                 `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`                                    // board error
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SolveResult) Reset() {
+	*x = SolveResult{}
+	mi := &file_sodogo_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SolveResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SolveResult) ProtoMessage() {}
+
+func (x *SolveResult) ProtoReflect() protoreflect.Message {
+	mi := &file_sodogo_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SolveResult.ProtoReflect.Descriptor instead.
+func (*SolveResult) Descriptor() ([]byte, []int) {
+	return file_sodogo_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SolveResult) GetStatus() Status {
+	if x != nil {
+		return x.Status
+	}
+	return Status_STATUS_UNSPECIFIED
+}
+
+func (x *SolveResult) GetBoard() *Board {
+	if x != nil {
+		return x.Board
+	}
+	return nil
+}
+
+func (x *SolveResult) GetSteps() int32 {
+	if x != nil {
+		return x.Steps
+	}
+	return 0
+}
+
+func (x *SolveResult) GetElapsedNanos() int64 {
+	if x != nil {
+		return x.ElapsedNanos
+	}
+	return 0
+}
+
+func (x *SolveResult) GetGrade() string {
+	if x != nil {
+		return x.Grade
+	}
+	return ""
+}
+
+func (x *SolveResult) GetHint() *Step {
+	if x != nil {
+		return x.Hint
+	}
+	return nil
+}
+
+func (x *SolveResult) GetTrace() []*Step {
+	if x != nil {
+		return x.Trace
+	}
+	return nil
+}
+
+func (x *SolveResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// SolveEvent a SolveSteps message, the steps and then the result.
+type SolveEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Event:
+	//
+	//	*SolveEvent_Step
+	//	*SolveEvent_Result
+	Event         isSolveEvent_Event `protobuf_oneof:"event"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SolveEvent) Reset() {
+	*x = SolveEvent{}
+	mi := &file_sodogo_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SolveEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SolveEvent) ProtoMessage() {}
+
+func (x *SolveEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_sodogo_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SolveEvent.ProtoReflect.Descriptor instead.
+func (*SolveEvent) Descriptor() ([]byte, []int) {
+	return file_sodogo_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SolveEvent) GetEvent() isSolveEvent_Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *SolveEvent) GetStep() *Step {
+	if x != nil {
+		if x, ok := x.Event.(*SolveEvent_Step); ok {
+			return x.Step
+		}
+	}
+	return nil
+}
+
+func (x *SolveEvent) GetResult() *SolveResult {
+	if x != nil {
+		if x, ok := x.Event.(*SolveEvent_Result); ok {
+			return x.Result
+		}
+	}
+	return nil
+}
+
+type isSolveEvent_Event interface {
+	isSolveEvent_Event()
+}
+
+type SolveEvent_Step struct {
+	Step *Step `protobuf:"bytes,1,opt,name=step,proto3,oneof"`
+}
+
+type SolveEvent_Result struct {
+	Result *SolveResult `protobuf:"bytes,2,opt,name=result,proto3,oneof"`
+}
+
+func (*SolveEvent_Step) isSolveEvent_Event() {}
+
+func (*SolveEvent_Result) isSolveEvent_Event() {}
+
+var File_sodogo_proto protoreflect.FileDescriptor
+
+const file_sodogo_proto_rawDesc = "" +
+	"\n" +
+	"\fsodogo.proto\x12\tsodogo.v1\"O\n" +
+	"\x05Board\x12\x12\n" +
+	"\x04size\x18\x01 \x01(\x05R\x04size\x12\x16\n" +
+	"\x06values\x18\x02 \x01(\tR\x06values\x12\x1a\n" +
+	"\balphabet\x18\x03 \x01(\tR\balphabet\"@\n" +
+	"\fSolveOptions\x12\x14\n" +
+	"\x05hyper\x18\x01 \x01(\bR\x05hyper\x12\x1a\n" +
+	"\bdisjoint\x18\x02 \x01(\bR\bdisjoint\"i\n" +
+	"\fSolveRequest\x12&\n" +
+	"\x05board\x18\x01 \x01(\v2\x10.sodogo.v1.BoardR\x05board\x121\n" +
+	"\aoptions\x18\x02 \x01(\v2\x17.sodogo.v1.SolveOptionsR\aoptions\"9\n" +
+	"\x0fGenerateRequest\x12\x12\n" +
+	"\x04size\x18\x01 \x01(\x05R\x04size\x12\x12\n" +
+	"\x04seed\x18\x02 \x01(\x03R\x04seed\"\x9c\x01\n" +
+	"\x04Step\x12\x12\n" +
+	"\x04pass\x18\x01 \x01(\x05R\x04pass\x12\x10\n" +
+	"\x03row\x18\x02 \x01(\x05R\x03row\x12\x10\n" +
+	"\x03col\x18\x03 \x01(\x05R\x03col\x12\x14\n" +
+	"\x05value\x18\x04 \x01(\x05R\x05value\x122\n" +
+	"\ttechnique\x18\x05 \x01(\x0e2\x14.sodogo.v1.TechniqueR\ttechnique\x12\x12\n" +
+	"\x04unit\x18\x06 \x01(\tR\x04unit\"\x93\x02\n" +
+	"\vSolveResult\x12)\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x11.sodogo.v1.StatusR\x06status\x12&\n" +
+	"\x05board\x18\x02 \x01(\v2\x10.sodogo.v1.BoardR\x05board\x12\x14\n" +
+	"\x05steps\x18\x03 \x01(\x05R\x05steps\x12#\n" +
+	"\relapsed_nanos\x18\x04 \x01(\x03R\felapsedNanos\x12\x14\n" +
+	"\x05grade\x18\x05 \x01(\tR\x05grade\x12#\n" +
+	"\x04hint\x18\x06 \x01(\v2\x0f.sodogo.v1.StepR\x04hint\x12%\n" +
+	"\x05trace\x18\a \x03(\v2\x0f.sodogo.v1.StepR\x05trace\x12\x14\n" +
+	"\x05error\x18\b \x01(\tR\x05error\"n\n" +
+	"\n" +
+	"SolveEvent\x12%\n" +
+	"\x04step\x18\x01 \x01(\v2\x0f.sodogo.v1.StepH\x00R\x04step\x120\n" +
+	"\x06result\x18\x02 \x01(\v2\x16.sodogo.v1.SolveResultH\x00R\x06resultB\a\n" +
+	"\x05event*\x86\x01\n" +
+	"\x06Status\x12\x16\n" +
+	"\x12STATUS_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rSTATUS_SOLVED\x10\x01\x12\x15\n" +
+	"\x11STATUS_UNSOLVABLE\x10\x02\x12\x10\n" +
+	"\fSTATUS_VALID\x10\x03\x12\x12\n" +
+	"\x0eSTATUS_INVALID\x10\x04\x12\x14\n" +
+	"\x10STATUS_GENERATED\x10\x05*_\n" +
+	"\tTechnique\x12\x19\n" +
+	"\x15TECHNIQUE_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16TECHNIQUE_NAKED_SINGLE\x10\x01\x12\x1b\n" +
+	"\x17TECHNIQUE_HIDDEN_SINGLE\x10\x022\xf2\x02\n" +
+	"\x06Solver\x128\n" +
+	"\x05Solve\x12\x17.sodogo.v1.SolveRequest\x1a\x16.sodogo.v1.SolveResult\x12>\n" +
+	"\n" +
+	"SolveSteps\x12\x17.sodogo.v1.SolveRequest\x1a\x15.sodogo.v1.SolveEvent0\x01\x12;\n" +
+	"\bValidate\x12\x17.sodogo.v1.SolveRequest\x1a\x16.sodogo.v1.SolveResult\x128\n" +
+	"\x05Grade\x12\x17.sodogo.v1.SolveRequest\x1a\x16.sodogo.v1.SolveResult\x127\n" +
+	"\x04Hint\x12\x17.sodogo.v1.SolveRequest\x1a\x16.sodogo.v1.SolveResult\x12>\n" +
+	"\bGenerate\x12\x1a.sodogo.v1.GenerateRequest\x1a\x16.sodogo.v1.SolveResultB+Z)github.com/rfiestas/sodogo/proto/sodogopbb\x06proto3"
+
+var (
+	file_sodogo_proto_rawDescOnce sync.Once
+	file_sodogo_proto_rawDescData []byte
+)
+
+func file_sodogo_proto_rawDescGZIP() []byte {
+	file_sodogo_proto_rawDescOnce.Do(func() {
+		file_sodogo_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_sodogo_proto_rawDesc), len(file_sodogo_proto_rawDesc)))
+	})
+	return file_sodogo_proto_rawDescData
+}
+
+var file_sodogo_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_sodogo_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_sodogo_proto_goTypes = []any{
+	(Status)(0),             // 0: sodogo.v1.Status
+	(Technique)(0),          // 1: sodogo.v1.Technique
+	(*Board)(nil),           // 2: sodogo.v1.Board
+	(*SolveOptions)(nil),    // 3: sodogo.v1.SolveOptions
+	(*SolveRequest)(nil),    // 4: sodogo.v1.SolveRequest
+	(*GenerateRequest)(nil), // 5: sodogo.v1.GenerateRequest
+	(*Step)(nil),            // 6: sodogo.v1.Step
+	(*SolveResult)(nil),     // 7: sodogo.v1.SolveResult
+	(*SolveEvent)(nil),      // 8: sodogo.v1.SolveEvent
+}
+var file_sodogo_proto_depIdxs = []int32{
+	2,  // 0: sodogo.v1.SolveRequest.board:type_name -> sodogo.v1.Board
+	3,  // 1: sodogo.v1.SolveRequest.options:type_name -> sodogo.v1.SolveOptions
+	1,  // 2: sodogo.v1.Step.technique:type_name -> sodogo.v1.Technique
+	0,  // 3: sodogo.v1.SolveResult.status:type_name -> sodogo.v1.Status
+	2,  // 4: sodogo.v1.SolveResult.board:type_name -> sodogo.v1.Board
+	6,  // 5: sodogo.v1.SolveResult.hint:type_name -> sodogo.v1.Step
+	6,  // 6: sodogo.v1.SolveResult.trace:type_name -> sodogo.v1.Step
+	6,  // 7: sodogo.v1.SolveEvent.step:type_name -> sodogo.v1.Step
+	7,  // 8: sodogo.v1.SolveEvent.result:type_name -> sodogo.v1.SolveResult
+	4,  // 9: sodogo.v1.Solver.Solve:input_type -> sodogo.v1.SolveRequest
+	4,  // 10: sodogo.v1.Solver.SolveSteps:input_type -> sodogo.v1.SolveRequest
+	4,  // 11: sodogo.v1.Solver.Validate:input_type -> sodogo.v1.SolveRequest
+	4,  // 12: sodogo.v1.Solver.Grade:input_type -> sodogo.v1.SolveRequest
+	4,  // 13: sodogo.v1.Solver.Hint:input_type -> sodogo.v1.SolveRequest
+	5,  // 14: sodogo.v1.Solver.Generate:input_type -> sodogo.v1.GenerateRequest
+	7,  // 15: sodogo.v1.Solver.Solve:output_type -> sodogo.v1.SolveResult
+	8,  // 16: sodogo.v1.Solver.SolveSteps:output_type -> sodogo.v1.SolveEvent
+	7,  // 17: sodogo.v1.Solver.Validate:output_type -> sodogo.v1.SolveResult
+	7,  // 18: sodogo.v1.Solver.Grade:output_type -> sodogo.v1.SolveResult
+	7,  // 19: sodogo.v1.Solver.Hint:output_type -> sodogo.v1.SolveResult
+	7,  // 20: sodogo.v1.Solver.Generate:output_type -> sodogo.v1.SolveResult
+	15, // [15:21] is the sub-list for method output_type
+	9,  // [9:15] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_sodogo_proto_init() }
+func file_sodogo_proto_init() {
+	if File_sodogo_proto != nil {
+		return
+	}
+	file_sodogo_proto_msgTypes[6].OneofWrappers = []any{
+		(*SolveEvent_Step)(nil),
+		(*SolveEvent_Result)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_sodogo_proto_rawDesc), len(file_sodogo_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_sodogo_proto_goTypes,
+		DependencyIndexes: file_sodogo_proto_depIdxs,
+		EnumInfos:         file_sodogo_proto_enumTypes,
+		MessageInfos:      file_sodogo_proto_msgTypes,
+	}.Build()
+	File_sodogo_proto = out.File
+	file_sodogo_proto_goTypes = nil
+	file_sodogo_proto_depIdxs = nil
+}
@@ -0,0 +1,344 @@
+// sodogo gRPC API, the messages follow the server package JSON API and the
+// SolveTrace steps of the library.
+//
+// The Go code in proto/sodogopb is generated from the module root with protoc,
+// protoc-gen-go and protoc-gen-go-grpc:
+//
+//   protoc -I proto \
+//          --go_out=. --go_opt=module=github.com/rfiestas/sodogo \
+//          --go-grpc_out=. --go-grpc_opt=module=github.com/rfiestas/sodogo \
+//          proto/sodogo.proto
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: sodogo.proto
+
+package sodogopb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Solver_Solve_FullMethodName      = "/sodogo.v1.Solver/Solve"
+	Solver_SolveSteps_FullMethodName = "/sodogo.v1.Solver/SolveSteps"
+	Solver_Validate_FullMethodName   = "/sodogo.v1.Solver/Validate"
+	Solver_Grade_FullMethodName      = "/sodogo.v1.Solver/Grade"
+	Solver_Hint_FullMethodName       = "/sodogo.v1.Solver/Hint"
+	Solver_Generate_FullMethodName   = "/sodogo.v1.Solver/Generate"
+)
+
+// SolverClient is the client API for Solver service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Solver solves, validates, grades and generates boards.
+type SolverClient interface {
+	// Solve solves the board.
+	Solve(ctx context.Context, in *SolveRequest, opts ...grpc.CallOption) (*SolveResult, error)
+	// SolveSteps solves the board, sending every filled cell as it happens
+	// and the result at the end.
+	SolveSteps(ctx context.Context, in *SolveRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SolveEvent], error)
+	// Validate checks the board is well formed and without conflicts.
+	Validate(ctx context.Context, in *SolveRequest, opts ...grpc.CallOption) (*SolveResult, error)
+	// Grade grades the board: easy, medium, hard or unsolvable.
+	Grade(ctx context.Context, in *SolveRequest, opts ...grpc.CallOption) (*SolveResult, error)
+	// Hint returns the next cell the solver fills.
+	Hint(ctx context.Context, in *SolveRequest, opts ...grpc.CallOption) (*SolveResult, error)
+	// Generate generates a puzzle the solver can finish.
+	Generate(ctx context.Context, in *GenerateRequest, opts ...grpc.CallOption) (*SolveResult, error)
+}
+
+type solverClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSolverClient(cc grpc.ClientConnInterface) SolverClient {
+	return &solverClient{cc}
+}
+
+func (c *solverClient) Solve(ctx context.Context, in *SolveRequest, opts ...grpc.CallOption) (*SolveResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SolveResult)
+	err := c.cc.Invoke(ctx, Solver_Solve_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *solverClient) SolveSteps(ctx context.Context, in *SolveRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SolveEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Solver_ServiceDesc.Streams[0], Solver_SolveSteps_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SolveRequest, SolveEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Solver_SolveStepsClient = grpc.ServerStreamingClient[SolveEvent]
+
+func (c *solverClient) Validate(ctx context.Context, in *SolveRequest, opts ...grpc.CallOption) (*SolveResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SolveResult)
+	err := c.cc.Invoke(ctx, Solver_Validate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *solverClient) Grade(ctx context.Context, in *SolveRequest, opts ...grpc.CallOption) (*SolveResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SolveResult)
+	err := c.cc.Invoke(ctx, Solver_Grade_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *solverClient) Hint(ctx context.Context, in *SolveRequest, opts ...grpc.CallOption) (*SolveResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SolveResult)
+	err := c.cc.Invoke(ctx, Solver_Hint_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *solverClient) Generate(ctx context.Context, in *GenerateRequest, opts ...grpc.CallOption) (*SolveResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SolveResult)
+	err := c.cc.Invoke(ctx, Solver_Generate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SolverServer is the server API for Solver service.
+// All implementations must embed UnimplementedSolverServer
+// for forward compatibility.
+//
+// Solver solves, validates, grades and generates boards.
+type SolverServer interface {
+	// Solve solves the board.
+	Solve(context.Context, *SolveRequest) (*SolveResult, error)
+	// SolveSteps solves the board, sending every filled cell as it happens
+	// and the result at the end.
+	SolveSteps(*SolveRequest, grpc.ServerStreamingServer[SolveEvent]) error
+	// Validate checks the board is well formed and without conflicts.
+	Validate(context.Context, *SolveRequest) (*SolveResult, error)
+	// Grade grades the board: easy, medium, hard or unsolvable.
+	Grade(context.Context, *SolveRequest) (*SolveResult, error)
+	// Hint returns the next cell the solver fills.
+	Hint(context.Context, *SolveRequest) (*SolveResult, error)
+	// Generate generates a puzzle the solver can finish.
+	Generate(context.Context, *GenerateRequest) (*SolveResult, error)
+	mustEmbedUnimplementedSolverServer()
+}
+
+// UnimplementedSolverServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSolverServer struct{}
+
+func (UnimplementedSolverServer) Solve(context.Context, *SolveRequest) (*SolveResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Solve not implemented")
+}
+func (UnimplementedSolverServer) SolveSteps(*SolveRequest, grpc.ServerStreamingServer[SolveEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method SolveSteps not implemented")
+}
+func (UnimplementedSolverServer) Validate(context.Context, *SolveRequest) (*SolveResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Validate not implemented")
+}
+func (UnimplementedSolverServer) Grade(context.Context, *SolveRequest) (*SolveResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Grade not implemented")
+}
+func (UnimplementedSolverServer) Hint(context.Context, *SolveRequest) (*SolveResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Hint not implemented")
+}
+func (UnimplementedSolverServer) Generate(context.Context, *GenerateRequest) (*SolveResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Generate not implemented")
+}
+func (UnimplementedSolverServer) mustEmbedUnimplementedSolverServer() {}
+func (UnimplementedSolverServer) testEmbeddedByValue()                {}
+
+// UnsafeSolverServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SolverServer will
+// result in compilation errors.
+type UnsafeSolverServer interface {
+	mustEmbedUnimplementedSolverServer()
+}
+
+func RegisterSolverServer(s grpc.ServiceRegistrar, srv SolverServer) {
+	// If the following call pancis, it indicates UnimplementedSolverServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Solver_ServiceDesc, srv)
+}
+
+func _Solver_Solve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SolveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SolverServer).Solve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Solver_Solve_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SolverServer).Solve(ctx, req.(*SolveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Solver_SolveSteps_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SolveRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SolverServer).SolveSteps(m, &grpc.GenericServerStream[SolveRequest, SolveEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Solver_SolveStepsServer = grpc.ServerStreamingServer[SolveEvent]
+
+func _Solver_Validate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SolveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SolverServer).Validate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Solver_Validate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SolverServer).Validate(ctx, req.(*SolveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Solver_Grade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SolveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SolverServer).Grade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Solver_Grade_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SolverServer).Grade(ctx, req.(*SolveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Solver_Hint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SolveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SolverServer).Hint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Solver_Hint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SolverServer).Hint(ctx, req.(*SolveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Solver_Generate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SolverServer).Generate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Solver_Generate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SolverServer).Generate(ctx, req.(*GenerateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Solver_ServiceDesc is the grpc.ServiceDesc for Solver service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Solver_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "sodogo.v1.Solver",
+	HandlerType: (*SolverServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Solve",
+			Handler:    _Solver_Solve_Handler,
+		},
+		{
+			MethodName: "Validate",
+			Handler:    _Solver_Validate_Handler,
+		},
+		{
+			MethodName: "Grade",
+			Handler:    _Solver_Grade_Handler,
+		},
+		{
+			MethodName: "Hint",
+			Handler:    _Solver_Hint_Handler,
+		},
+		{
+			MethodName: "Generate",
+			Handler:    _Solver_Generate_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SolveSteps",
+			Handler:       _Solver_SolveSteps_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "sodogo.proto",
+}
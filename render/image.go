@@ -0,0 +1,82 @@
+package render
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io"
+
+	"github.com/rfiestas/sodogo"
+)
+
+// Image draws the board as an image
+func Image(b *sodogo.Board, opts Options) *image.RGBA {
+	opts = opts.withDefaults()
+	g := newGrid(b)
+	cells, lines, size := g.layout(b, opts)
+
+	img := image.NewRGBA(image.Rect(0, 0, size, size))
+	fillRect(img, img.Bounds(), opts.Background)
+	for _, c := range cells {
+		fillRect(img, image.Rect(c.x, c.y, c.x+opts.CellSize, c.y+opts.CellSize), c.fill)
+	}
+	for _, l := range lines {
+		fillRect(img, image.Rect(l.x1-l.width/2, l.y1-l.width/2, l.x2-l.width/2+l.width, l.y2-l.width/2+l.width), l.color)
+	}
+
+	digitScale := maxInt(1, opts.CellSize*6/10/glyphHeight)
+	mark := opts.CellSize / g.flats
+	markScale := maxInt(1, mark*6/10/glyphHeight)
+	for _, c := range cells {
+		if c.value != 0 {
+			textColor := opts.Solved
+			if c.given {
+				textColor = opts.Given
+			}
+			drawGlyph(img, g.symbols[c.value-1], c.x+opts.CellSize/2, c.y+opts.CellSize/2, digitScale, textColor)
+			continue
+		}
+		for _, value := range c.candidates {
+			col, row := (value-1)%g.flats, (value-1)/g.flats
+			drawGlyph(img, g.symbols[value-1], c.x+col*mark+mark/2, c.y+row*mark+mark/2, markScale, opts.PencilMark)
+		}
+	}
+	return img
+}
+
+// PNG writes the board as a PNG image
+func PNG(w io.Writer, b *sodogo.Board, opts Options) error {
+	return png.Encode(w, Image(b, opts))
+}
+
+// fillRect fills a rectangle with a color
+func fillRect(img draw.Image, r image.Rectangle, c color.Color) {
+	draw.Draw(img, r, &image.Uniform{C: c}, image.Point{}, draw.Src)
+}
+
+// drawGlyph draws a symbol of the bitmap font centered on x, y
+func drawGlyph(img draw.Image, symbol byte, x int, y int, scale int, c color.Color) {
+	if symbol < ' ' || symbol > '~' {
+		return
+	}
+	glyph := font5x8[symbol-' ']
+	left, top := x-glyphWidth*scale/2, y-glyphBaseline*scale/2
+	for col, bits := range glyph {
+		for row := 0; row < glyphHeight; row++ {
+			if bits&(1<<uint(row)) == 0 {
+				continue
+			}
+			px, py := left+col*scale, top+row*scale
+			fillRect(img, image.Rect(px, py, px+scale, py+scale), c)
+		}
+	}
+}
+
+// maxInt returns the greatest value
+func maxInt(a int, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
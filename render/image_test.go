@@ -0,0 +1,86 @@
+package render
+
+import (
+	"bytes"
+	"image/color"
+	"image/png"
+	"testing"
+
+	"github.com/rfiestas/sodogo"
+)
+
+func TestImage(t *testing.T) {
+	b := testBoardFilled()
+	opts := Options{Highlighted: []sodogo.Position{{Row: 3, Col: 3}}}
+	img := Image(b, opts)
+	opts = opts.withDefaults()
+
+	tests := []struct {
+		name string
+		x, y int
+		want color.Color
+	}{
+		{name: "margin", x: 1, y: 1, want: opts.Background},
+		{name: "outside border", x: 12, y: 100, want: opts.Border},
+		{name: "flat border", x: 108, y: 30, want: opts.Border},
+		{name: "cell line", x: 60, y: 150, want: opts.Line},
+		{name: "highlighted cell", x: 160, y: 160, want: opts.Highlight},
+		{name: "empty cell", x: 130, y: 130, want: opts.Background},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if res := img.At(tt.x, tt.y); !sameColor(res, tt.want) {
+				t.Errorf("Image() At(%d, %d) = %v, want %v", tt.x, tt.y, res, tt.want)
+			}
+		})
+	}
+
+	given, solved := 0, 0
+	for y := 12; y < 60; y++ {
+		for x := 12; x < 108; x++ {
+			switch res := img.At(x, y); {
+			case x < 60 && sameColor(res, opts.Given) && x > 16 && y > 16:
+				given++
+			case x > 60 && sameColor(res, opts.Solved):
+				solved++
+			}
+		}
+	}
+	if given == 0 || solved == 0 {
+		t.Errorf("Image() given pixels = %v, solved pixels = %v, want both", given, solved)
+	}
+}
+
+func TestPNG(t *testing.T) {
+	b := sodogo.NewBoard(sodogo.NewHelperBoard(2))
+	_ = b.LoadFromString("1002000000000000")
+	var buffer bytes.Buffer
+	if err := PNG(&buffer, b, Options{CellSize: 20, PencilMarks: true}); err != nil {
+		t.Fatalf("PNG() error = %v", err)
+	}
+	img, err := png.Decode(&buffer)
+	if err != nil {
+		t.Fatalf("png.Decode() error = %v", err)
+	}
+	if size := img.Bounds().Dx(); size != 4*20+2*5 {
+		t.Errorf("PNG() size = %v, want %v", size, 4*20+2*5)
+	}
+}
+
+func Test_drawGlyph(t *testing.T) {
+	b := testBoardFilled()
+	img := Image(b, Options{Given: color.RGBA{0xff, 0, 0, 0xff}})
+	// '1' vertical stroke, third column of the glyph, centered on the first cell
+	scale := 48 * 6 / 10 / glyphHeight
+	x, y := 12+24-glyphWidth*scale/2+2*scale, 12+24
+	if res := img.At(x, y); !sameColor(res, color.RGBA{0xff, 0, 0, 0xff}) {
+		t.Errorf("drawGlyph() At(%d, %d) = %v, want red", x, y, res)
+	}
+}
+
+// sameColor returns if two colors are equal
+func sameColor(a color.Color, b color.Color) bool {
+	ar, ag, ab, aa := a.RGBA()
+	br, bg, bb, ba := b.RGBA()
+	return ar == br && ag == bg && ab == bb && aa == ba
+}
@@ -0,0 +1,216 @@
+// Package render draws sodogo boards as SVG and PNG images with the standard
+// library only, through the public cell accessors of the board:
+//
+//	f, _ := os.Create("board.png")
+//	err := render.PNG(f, board, render.Options{CellSize: 64, PencilMarks: true})
+//
+// It is a package of its own so the solver does not import the image encoders.
+package render
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"image/color"
+	"io"
+
+	"github.com/rfiestas/sodogo"
+)
+
+// Options image rendering options, zero values use the defaults
+type Options struct {
+	CellSize    int               // cell side in pixels, 48 by default
+	PencilMarks bool              // draw the candidates of the empty cells
+	Highlighted []sodogo.Position // cells drawn with the Highlight background
+
+	Background color.Color // cell background, white by default
+	Given      color.Color // given values, black by default
+	Solved     color.Color // filled values, blue by default
+	PencilMark color.Color // candidates, gray by default
+	Highlight  color.Color // highlighted cells background, yellow by default
+	ExtraGroup color.Color // hyper or disjoint groups background, light gray by default
+	Line       color.Color // cell lines, gray by default
+	Border     color.Color // region borders, black by default
+}
+
+// grid the board as the renderer reads it
+type grid struct {
+	maxValue int    // values, cells per row
+	flats    int    // flat side
+	symbols  string // value symbols, the symbol of value 1 first
+	boxes    []int  // box number by cell, in board order
+	extra    []bool // cells in a hyper or disjoint group
+}
+
+// renderCell a cell ready to draw
+type renderCell struct {
+	x, y       int         // top left corner
+	value      int         // cell value, 0 when empty
+	given      bool        // value loaded with the puzzle
+	candidates []int       // pencil marks of an empty cell
+	fill       color.Color // background
+}
+
+// renderLine a horizontal or vertical line
+type renderLine struct {
+	x1, y1, x2, y2 int
+	width          int
+	color          color.Color
+}
+
+// SVG writes the board as an SVG image
+func SVG(w io.Writer, b *sodogo.Board, opts Options) error {
+	opts = opts.withDefaults()
+	g := newGrid(b)
+	cells, lines, size := g.layout(b, opts)
+
+	var buffer bytes.Buffer
+	fmt.Fprintf(&buffer, "<svg xmlns=\"http://www.w3.org/2000/svg\" width=\"%d\" height=\"%d\" viewBox=\"0 0 %d %d\">\n", size, size, size, size)
+	fmt.Fprintf(&buffer, "<rect width=\"%d\" height=\"%d\" fill=\"%s\"/>\n", size, size, svgColor(opts.Background))
+	for _, c := range cells {
+		fmt.Fprintf(&buffer, "<rect x=\"%d\" y=\"%d\" width=\"%d\" height=\"%d\" fill=\"%s\"/>\n", c.x, c.y, opts.CellSize, opts.CellSize, svgColor(c.fill))
+	}
+	for _, l := range lines {
+		fmt.Fprintf(&buffer, "<line x1=\"%d\" y1=\"%d\" x2=\"%d\" y2=\"%d\" stroke=\"%s\" stroke-width=\"%d\" stroke-linecap=\"square\"/>\n", l.x1, l.y1, l.x2, l.y2, svgColor(l.color), l.width)
+	}
+	for _, c := range cells {
+		if c.value != 0 {
+			textColor := opts.Solved
+			if c.given {
+				textColor = opts.Given
+			}
+			g.writeSVGText(&buffer, c.x+opts.CellSize/2, c.y+opts.CellSize/2, opts.CellSize*6/10, c.value, textColor)
+			continue
+		}
+		mark := opts.CellSize / g.flats
+		for _, value := range c.candidates {
+			col, row := (value-1)%g.flats, (value-1)/g.flats
+			g.writeSVGText(&buffer, c.x+col*mark+mark/2, c.y+row*mark+mark/2, mark*7/10, value, opts.PencilMark)
+		}
+	}
+	buffer.WriteString("</svg>\n")
+
+	_, err := w.Write(buffer.Bytes())
+	return err
+}
+
+// writeSVGText writes a value symbol centered on x, y
+func (g grid) writeSVGText(buffer *bytes.Buffer, x int, y int, size int, value int, c color.Color) {
+	symbol := html.EscapeString(string(g.symbols[value-1]))
+	fmt.Fprintf(buffer, "<text x=\"%d\" y=\"%d\" font-family=\"sans-serif\" font-size=\"%d\" fill=\"%s\" text-anchor=\"middle\" dominant-baseline=\"central\">%s</text>\n", x, y, size, svgColor(c), symbol)
+}
+
+// newGrid reads the size, symbols, boxes and extra groups of the board
+func newGrid(b *sodogo.Board) (g grid) {
+	g.symbols = b.Alphabet()
+	g.maxValue = len(g.symbols)
+	for g.flats*g.flats < g.maxValue {
+		g.flats++
+	}
+	g.boxes = make([]int, g.maxValue*g.maxValue)
+	g.extra = make([]bool, g.maxValue*g.maxValue)
+	for _, unit := range b.Units() {
+		for _, p := range unit.Cells {
+			switch unit.Kind {
+			case sodogo.UnitBox:
+				g.boxes[p.Row*g.maxValue+p.Col] = unit.Index
+			case sodogo.UnitGroup:
+				g.extra[p.Row*g.maxValue+p.Col] = true
+			}
+		}
+	}
+	return g
+}
+
+// layout returns the cells, the lines and the image side in pixels
+func (g grid) layout(b *sodogo.Board, opts Options) (cells []renderCell, lines []renderLine, size int) {
+	maxValue, cellSize := g.maxValue, opts.CellSize
+	margin := cellSize / 4
+	size = maxValue*cellSize + 2*margin
+	thin, thick := 1+cellSize/48, 2+cellSize/16
+
+	highlighted := map[sodogo.Position]bool{}
+	for _, p := range opts.Highlighted {
+		highlighted[p] = true
+	}
+	for pos := 0; pos < maxValue*maxValue; pos++ {
+		row, col := pos/maxValue, pos%maxValue
+		value, _ := b.Get(row, col)
+		c := renderCell{
+			x:     margin + col*cellSize,
+			y:     margin + row*cellSize,
+			value: value,
+			given: b.IsGiven(row, col),
+			fill:  opts.Background,
+		}
+		if g.extra[pos] {
+			c.fill = opts.ExtraGroup
+		}
+		if highlighted[sodogo.Position{Row: row, Col: col}] {
+			c.fill = opts.Highlight
+		}
+		if c.value == 0 && opts.PencilMarks {
+			c.candidates, _ = b.Candidates(row, col)
+		}
+		cells = append(cells, c)
+	}
+
+	// thin lines first, region borders are drawn over them
+	var borders []renderLine
+	for pos, c := range cells {
+		row, col := pos/maxValue, pos%maxValue
+		right := renderLine{c.x + cellSize, c.y, c.x + cellSize, c.y + cellSize, thin, opts.Line}
+		if col == maxValue-1 || g.boxes[pos] != g.boxes[pos+1] {
+			right.width, right.color = thick, opts.Border
+			borders = append(borders, right)
+		} else {
+			lines = append(lines, right)
+		}
+		bottom := renderLine{c.x, c.y + cellSize, c.x + cellSize, c.y + cellSize, thin, opts.Line}
+		if row == maxValue-1 || g.boxes[pos] != g.boxes[pos+maxValue] {
+			bottom.width, bottom.color = thick, opts.Border
+			borders = append(borders, bottom)
+		} else {
+			lines = append(lines, bottom)
+		}
+		if col == 0 {
+			borders = append(borders, renderLine{c.x, c.y, c.x, c.y + cellSize, thick, opts.Border})
+		}
+		if row == 0 {
+			borders = append(borders, renderLine{c.x, c.y, c.x + cellSize, c.y, thick, opts.Border})
+		}
+	}
+	return cells, append(lines, borders...), size
+}
+
+// withDefaults returns the options with the default values of the unset fields
+func (opts Options) withDefaults() Options {
+	if opts.CellSize <= 0 {
+		opts.CellSize = 48
+	}
+	defaults := []struct {
+		field *color.Color
+		value color.Color
+	}{
+		{&opts.Background, color.White},
+		{&opts.Given, color.Black},
+		{&opts.Solved, color.RGBA{0x1f, 0x4e, 0xc8, 0xff}},
+		{&opts.PencilMark, color.RGBA{0x70, 0x70, 0x70, 0xff}},
+		{&opts.Highlight, color.RGBA{0xff, 0xec, 0x8b, 0xff}},
+		{&opts.ExtraGroup, color.RGBA{0xdd, 0xdd, 0xdd, 0xff}},
+		{&opts.Line, color.RGBA{0x99, 0x99, 0x99, 0xff}},
+		{&opts.Border, color.Black},
+	}
+	for _, d := range defaults {
+		if *d.field == nil {
+			*d.field = d.value
+		}
+	}
+	return opts
+}
+
+// svgColor returns the color as #rrggbb
+func svgColor(c color.Color) string {
+	r, g, b, _ := c.RGBA()
+	return fmt.Sprintf("#%02x%02x%02x", r>>8, g>>8, b>>8)
+}
@@ -0,0 +1,124 @@
+package render
+
+import (
+	"bytes"
+	"image/color"
+	"strings"
+	"testing"
+
+	"github.com/rfiestas/sodogo"
+)
+
+// testBoardFilled returns a 2x2 board with a given and filled cells
+func testBoardFilled() *sodogo.Board {
+	b := sodogo.NewBoard(sodogo.NewHelperBoard(2))
+	_ = b.LoadFromString("1000000000000000")
+	for col, value := range []int{2, 3, 4} {
+		_ = b.Set(0, col+1, value)
+	}
+	return b
+}
+
+func TestSVG(t *testing.T) {
+	hyper := sodogo.NewBoard(sodogo.NewHelperBoard(2).WithHyper())
+	_ = hyper.LoadFromString("1000000000000000")
+	alphabet, _ := sodogo.NewHelperBoard(2).WithAlphabet("<&>\"")
+	escaped := sodogo.NewBoard(alphabet)
+	_ = escaped.LoadFromString("<&>\"000000000000")
+
+	tests := []struct {
+		name     string
+		b        *sodogo.Board
+		opts     Options
+		want     []string
+		wantNot  []string
+		wantText int
+	}{
+		{
+			name: "2x2 givens and solved",
+			b:    testBoardFilled(),
+			want: []string{
+				`width="216" height="216"`,
+				`font-size="28" fill="#000000" text-anchor="middle" dominant-baseline="central">1</text>`,
+				`font-size="28" fill="#1f4ec8" text-anchor="middle" dominant-baseline="central">2</text>`,
+			},
+			wantText: 4,
+		},
+		{
+			name: "2x2 pencil marks",
+			b:    testBoardFilled(),
+			opts: Options{PencilMarks: true, PencilMark: color.RGBA{0x12, 0x34, 0x56, 0xff}},
+			want: []string{
+				`<text x="24" y="96" font-family="sans-serif" font-size="16" fill="#123456" text-anchor="middle" dominant-baseline="central">3</text>`,
+			},
+			wantText: 4 + 32,
+		},
+		{
+			name: "2x2 highlighted",
+			b:    testBoardFilled(),
+			opts: Options{CellSize: 10, Highlighted: []sodogo.Position{{Row: 1, Col: 2}, {Row: 9, Col: 9}}},
+			want: []string{
+				`<rect x="22" y="12" width="10" height="10" fill="#ffec8b"/>`,
+				`width="44" height="44"`,
+			},
+			wantText: 4,
+		},
+		{
+			name: "2x2 hyper",
+			b:    hyper,
+			want: []string{
+				`<rect x="60" y="60" width="48" height="48" fill="#dddddd"/>`,
+			},
+			wantNot:  []string{`<rect x="12" y="12" width="48" height="48" fill="#dddddd"/>`},
+			wantText: 1,
+		},
+		{
+			name:     "2x2 escaped alphabet",
+			b:        escaped,
+			want:     []string{">&lt;</text>", ">&amp;</text>", ">&gt;</text>", ">&#34;</text>"},
+			wantText: 4,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buffer bytes.Buffer
+			if err := SVG(&buffer, tt.b, tt.opts); err != nil {
+				t.Fatalf("SVG() error = %v", err)
+			}
+			res := buffer.String()
+			for _, want := range tt.want {
+				if !strings.Contains(res, want) {
+					t.Errorf("SVG() res does not contain %v\n%v", want, res)
+				}
+			}
+			for _, wantNot := range tt.wantNot {
+				if strings.Contains(res, wantNot) {
+					t.Errorf("SVG() res contains %v", wantNot)
+				}
+			}
+			if count := strings.Count(res, "<text"); count != tt.wantText {
+				t.Errorf("SVG() texts = %v, want %v", count, tt.wantText)
+			}
+		})
+	}
+}
+
+func Test_grid_layout(t *testing.T) {
+	b := testBoardFilled()
+	_, lines, size := newGrid(b).layout(b, Options{}.withDefaults())
+	if size != 216 {
+		t.Errorf("grid.layout() size = %v, want 216", size)
+	}
+	thin, thick := 0, 0
+	for _, l := range lines {
+		if l.width == 2+48/16 {
+			thick++
+		} else {
+			thin++
+		}
+	}
+	// 4 thin cell lines per row and column, 3 thick ones plus the outside border
+	if thin != 16 || thick != 24 {
+		t.Errorf("grid.layout() thin, thick = %v, %v, want 16, 24", thin, thick)
+	}
+}
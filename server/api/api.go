@@ -0,0 +1,202 @@
+// Package api runs the sodogo solver requests inside the request limits,
+// without any transport. The HTTP server, the gRPC server and the
+// WebAssembly bridge decode their requests into a Request and run it:
+//
+//	h := api.New(api.Limits{})
+//	res, err := h.Call(ctx, "solve", api.Request{Board: puzzle})
+//
+// The endpoints are solve, validate, grade, hint and generate.
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"sort"
+	"time"
+
+	"github.com/rfiestas/sodogo"
+)
+
+// Response statuses
+const (
+	StatusSolved     = "solved"
+	StatusUnsolvable = "unsolvable"
+	StatusValid      = "valid"
+	StatusInvalid    = "invalid"
+	StatusGenerated  = "generated"
+)
+
+// Request limit errors
+var (
+	ErrBusy    = errors.New("Too many requests")
+	ErrTimeout = errors.New("Request timeout")
+)
+
+// Limits request limits, zero values use the defaults
+type Limits struct {
+	Timeout       time.Duration // request timeout, 10s by default
+	MaxConcurrent int           // requests solving at the same time, the number of CPUs by default
+	MaxSize       int           // greatest box size, 5 (25x25 boards) by default
+	MaxGenerate   int           // greatest generate box size, 4 (16x16 boards) by default
+}
+
+// Request a request body
+type Request struct {
+	Size    int     `json:"size"`    // box size, 3 for 9x9 boards, 3 by default
+	Board   string  `json:"board"`   // board as a line or a text grid, not used by generate
+	Options Options `json:"options"` // board variants
+}
+
+// Options board variants
+type Options struct {
+	Hyper    bool   `json:"hyper,omitempty"`    // hyper windows, not for generate
+	Disjoint bool   `json:"disjoint,omitempty"` // disjoint groups, not for generate
+	Alphabet string `json:"alphabet,omitempty"` // a symbol per value
+	Seed     int64  `json:"seed,omitempty"`     // generate random seed, the current time by default
+}
+
+// Response a response body
+type Response struct {
+	Status  string `json:"status,omitempty"`  // solved, unsolvable, valid, invalid or generated
+	Board   string `json:"board,omitempty"`   // solved, partial or generated board
+	Steps   int    `json:"steps,omitempty"`   // solver steps
+	Elapsed string `json:"elapsed,omitempty"` // solver elapsed time
+	Grade   string `json:"grade,omitempty"`   // easy, medium, hard or unsolvable
+	Hint    *Hint  `json:"hint,omitempty"`    // next cell the solver fills
+	Error   string `json:"error,omitempty"`   // request or board error
+}
+
+// Hint a cell and its value, rows and columns from 0
+type Hint struct {
+	Row   int `json:"row"`
+	Col   int `json:"col"`
+	Value int `json:"value"`
+}
+
+// Handler runs the requests inside the limits
+type Handler struct {
+	limits Limits
+	slots  chan struct{}
+}
+
+// New returns a handler with the limits
+func New(limits Limits) *Handler {
+	limits = limits.withDefaults()
+	return &Handler{limits: limits, slots: make(chan struct{}, limits.MaxConcurrent)}
+}
+
+// Names returns the endpoint names, sorted
+func Names() (names []string) {
+	for name := range endpoints {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Call runs an endpoint. Boards that can not be loaded are errors IsInvalid
+// reports, requests over the limits are ErrBusy or ErrTimeout errors.
+func (h *Handler) Call(ctx context.Context, name string, req Request) (res Response, err error) {
+	endpoint, ok := endpoints[name]
+	if !ok {
+		return res, fmt.Errorf("Unknown endpoint %q", name)
+	}
+	if req, err = h.checkSize(name, req); err != nil {
+		return res, err
+	}
+	return h.run(ctx, func(ctx context.Context) (Response, error) {
+		return endpoint(ctx, req)
+	})
+}
+
+// SolveTrace solves the board like the solve endpoint, calling step for
+// every filled cell in order
+func (h *Handler) SolveTrace(ctx context.Context, req Request, step func(sodogo.TraceStep)) (res Response, err error) {
+	if req, err = h.checkSize("solve", req); err != nil {
+		return res, err
+	}
+	return h.run(ctx, func(context.Context) (Response, error) {
+		return solveTrace(req, step)
+	})
+}
+
+// checkSize returns the request with the default size, or an error when the
+// size is over the limits
+func (h *Handler) checkSize(name string, req Request) (Request, error) {
+	if req.Size == 0 {
+		req.Size = 3
+	}
+	if req.Size < 2 || req.Size > h.limits.MaxSize {
+		return req, fmt.Errorf("Invalid size %d, valid sizes are 2 to %d", req.Size, h.limits.MaxSize)
+	}
+	if name == "generate" && req.Size > h.limits.MaxGenerate {
+		return req, fmt.Errorf("Invalid size %d, valid generate sizes are 2 to %d", req.Size, h.limits.MaxGenerate)
+	}
+	return req, nil
+}
+
+// run runs an endpoint with the timeout once a slot is free. On timeout the
+// slot is released when the endpoint ends, a cancellable endpoint ends soon
+// after it.
+func (h *Handler) run(ctx context.Context, endpoint func(ctx context.Context) (Response, error)) (Response, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.limits.Timeout)
+	defer cancel()
+	select {
+	case h.slots <- struct{}{}:
+	case <-ctx.Done():
+		return Response{}, ErrBusy
+	}
+
+	type result struct {
+		res Response
+		err error
+	}
+	done := make(chan result, 1)
+	go func() {
+		defer func() { <-h.slots }()
+		res, err := endpoint(ctx)
+		done <- result{res, err}
+	}()
+
+	select {
+	case <-ctx.Done():
+		return Response{}, ErrTimeout
+	case d := <-done:
+		return d.res, d.err
+	}
+}
+
+// IsInvalid reports whether an endpoint error is a board that can not be
+// loaded
+func IsInvalid(err error) bool {
+	var invalid errInvalid
+	return errors.As(err, &invalid)
+}
+
+// ErrorResponse returns the response of an endpoint error, with the invalid
+// status for the boards that can not be loaded
+func ErrorResponse(err error) Response {
+	if IsInvalid(err) {
+		return Response{Status: StatusInvalid, Error: err.Error()}
+	}
+	return Response{Error: err.Error()}
+}
+
+// withDefaults returns the limits with the default values of the unset fields
+func (l Limits) withDefaults() Limits {
+	if l.Timeout <= 0 {
+		l.Timeout = 10 * time.Second
+	}
+	if l.MaxConcurrent <= 0 {
+		l.MaxConcurrent = runtime.NumCPU()
+	}
+	if l.MaxSize <= 0 {
+		l.MaxSize = 5
+	}
+	if l.MaxGenerate <= 0 {
+		l.MaxGenerate = 4
+	}
+	return l
+}
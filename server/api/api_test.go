@@ -0,0 +1,122 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/rfiestas/sodogo"
+)
+
+const (
+	testPuzzle   = "004300209005009001070060043006002087190007400050083000600000105003508690042910300"
+	testSolution = "864371259325849761971265843436192587198657432257483916689734125713528694542916378"
+)
+
+func TestHandler_Call(t *testing.T) {
+	tests := []struct {
+		name    string
+		limits  Limits
+		call    string
+		req     Request
+		want    Response
+		wantErr string
+	}{
+		{
+			name: "solve",
+			call: "solve",
+			req:  Request{Board: testPuzzle},
+			want: Response{Status: StatusSolved, Board: testSolution, Steps: 4},
+		},
+		{
+			name:    "solve invalid board",
+			call:    "solve",
+			req:     Request{Board: "123"},
+			wantErr: "A valid board text contains 81 cells, not 3",
+		},
+		{
+			name:    "invalid size",
+			call:    "grade",
+			req:     Request{Size: 6},
+			wantErr: "Invalid size 6, valid sizes are 2 to 5",
+		},
+		{
+			name:    "max size",
+			limits:  Limits{MaxSize: 7},
+			call:    "grade",
+			req:     Request{Size: 8},
+			wantErr: "Invalid size 8, valid sizes are 2 to 7",
+		},
+		{
+			name:    "generate size",
+			call:    "generate",
+			req:     Request{Size: 5},
+			wantErr: "Invalid size 5, valid generate sizes are 2 to 4",
+		},
+		{
+			name:    "unknown endpoint",
+			call:    "print",
+			wantErr: `Unknown endpoint "print"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := New(tt.limits).Call(context.Background(), tt.call, tt.req)
+			res.Elapsed = ""
+			if (err != nil || tt.wantErr != "") && (err == nil || err.Error() != tt.wantErr) {
+				t.Fatalf("Handler.Call() error = %v, want %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(res, tt.want) {
+				t.Errorf("Handler.Call() = %+v, want %+v", res, tt.want)
+			}
+		})
+	}
+}
+
+func TestHandler_SolveTrace(t *testing.T) {
+	steps := 0
+	res, err := New(Limits{}).SolveTrace(context.Background(), Request{Board: testPuzzle}, func(sodogo.TraceStep) { steps++ })
+	if err != nil || res.Status != StatusSolved || res.Board != testSolution || steps != 46 {
+		t.Errorf("Handler.SolveTrace() = %+v %v with %d steps, want solved with 46 steps", res, err, steps)
+	}
+}
+
+func TestHandler_limits(t *testing.T) {
+	release := make(chan struct{})
+	h := New(Limits{Timeout: 50 * time.Millisecond, MaxConcurrent: 1})
+	slow := func(context.Context) (Response, error) {
+		<-release
+		return Response{Status: StatusSolved}, nil
+	}
+
+	// the first request times out, its endpoint keeps the slot
+	if _, err := h.run(context.Background(), slow); !errors.Is(err, ErrTimeout) {
+		t.Errorf("first request error = %v, want %v", err, ErrTimeout)
+	}
+	if _, err := h.run(context.Background(), slow); !errors.Is(err, ErrBusy) {
+		t.Errorf("second request error = %v, want %v", err, ErrBusy)
+	}
+
+	close(release)
+	deadline := time.Now().Add(time.Second)
+	for len(h.slots) != 0 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if res, err := h.run(context.Background(), slow); err != nil || res.Status != StatusSolved {
+		t.Errorf("third request = %+v %v, want solved", res, err)
+	}
+}
+
+func TestErrorResponse(t *testing.T) {
+	_, err := New(Limits{}).Call(context.Background(), "solve", Request{Board: "123"})
+	want := Response{Status: StatusInvalid, Error: "A valid board text contains 81 cells, not 3"}
+	if res := ErrorResponse(err); !IsInvalid(err) || !reflect.DeepEqual(res, want) {
+		t.Errorf("ErrorResponse() = %+v, want %+v", res, want)
+	}
+	want = Response{Error: "Request timeout"}
+	if res := ErrorResponse(ErrTimeout); IsInvalid(ErrTimeout) || !reflect.DeepEqual(res, want) {
+		t.Errorf("ErrorResponse() = %+v, want %+v", res, want)
+	}
+}
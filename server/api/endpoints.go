@@ -0,0 +1,139 @@
+package api
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"github.com/rfiestas/sodogo"
+)
+
+// endpoints request handlers by name, load errors are errInvalid
+var endpoints = map[string]func(ctx context.Context, req Request) (Response, error){
+	"solve":    solve,
+	"validate": validate,
+	"grade":    grade,
+	"hint":     hint,
+	"generate": generate,
+}
+
+// errInvalid a board that can not be loaded
+type errInvalid struct {
+	err error
+}
+
+func (e errInvalid) Error() string {
+	return e.err.Error()
+}
+
+// solve solves the board
+func solve(_ context.Context, req Request) (res Response, err error) {
+	return solveTrace(req, nil)
+}
+
+// solveTrace solves the board, calling step for every filled cell when it is
+// not nil
+func solveTrace(req Request, step func(sodogo.TraceStep)) (res Response, err error) {
+	b, err := loadBoard(req)
+	if err != nil {
+		return res, err
+	}
+	res.Status = StatusUnsolvable
+	if b.SolveTrace(step) {
+		res.Status = StatusSolved
+	}
+	res.Board, res.Steps, res.Elapsed = b.String(), b.Steps, b.Elapsed.String()
+	return res, nil
+}
+
+// validate checks the board is well formed and without conflicts
+func validate(_ context.Context, req Request) (res Response, err error) {
+	b, err := loadBoard(req)
+	if IsInvalid(err) {
+		return Response{Status: StatusInvalid, Error: err.Error()}, nil
+	}
+	if err != nil {
+		return res, err
+	}
+	if err := b.Validate(); err != nil {
+		return Response{Status: StatusInvalid, Board: b.String(), Error: err.Error()}, nil
+	}
+	return Response{Status: StatusValid, Board: b.String()}, nil
+}
+
+// grade grades the board: easy, medium, hard or unsolvable
+func grade(_ context.Context, req Request) (res Response, err error) {
+	b, err := loadBoard(req)
+	if err != nil {
+		return res, err
+	}
+	return Response{Grade: b.Grade()}, nil
+}
+
+// hint returns the next cell the solver fills, or the solved or unsolvable
+// status when there is none
+func hint(_ context.Context, req Request) (res Response, err error) {
+	b, err := loadBoard(req)
+	if err != nil {
+		return res, err
+	}
+	pos, value, ok := b.Hint()
+	if !ok {
+		res.Status = StatusUnsolvable
+		if b.Solve() {
+			res.Status = StatusSolved
+		}
+		return res, nil
+	}
+	return Response{Hint: &Hint{Row: pos.Row, Col: pos.Col, Value: value}}, nil
+}
+
+// generate generates a puzzle the solver can finish, stops when the context
+// is done
+func generate(ctx context.Context, req Request) (res Response, err error) {
+	h, err := helpers(req)
+	if err != nil {
+		return res, err
+	}
+	seed := req.Options.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	b, err := sodogo.GenerateContext(ctx, h, rand.New(rand.NewSource(seed)))
+	if err != nil {
+		return res, err
+	}
+	return Response{Status: StatusGenerated, Board: b.String(), Grade: b.Grade()}, nil
+}
+
+// helpers returns the board helpers of a request
+func helpers(req Request) (h sodogo.HelperBoard, err error) {
+	h, err = sodogo.NewCheckedHelperBoard(req.Size)
+	if err != nil {
+		return h, err
+	}
+	if req.Options.Hyper {
+		h = h.WithHyper()
+	}
+	if req.Options.Disjoint {
+		h = h.WithDisjointGroups()
+	}
+	if req.Options.Alphabet != "" {
+		return h.WithAlphabet(req.Options.Alphabet)
+	}
+	return h, nil
+}
+
+// loadBoard returns the board of a request, an errInvalid error when it can
+// not be loaded
+func loadBoard(req Request) (b *sodogo.Board, err error) {
+	h, err := helpers(req)
+	if err != nil {
+		return b, err
+	}
+	b = sodogo.NewBoard(h)
+	if err := b.LoadFromText(req.Board); err != nil {
+		return b, errInvalid{err}
+	}
+	return b, nil
+}
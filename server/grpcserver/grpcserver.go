@@ -0,0 +1,200 @@
+// Package grpcserver serves the sodogo solver as the Solver gRPC service of
+// proto/sodogo.proto, with the api package request handling:
+//
+//	lis, _ := net.Listen("tcp", ":9090")
+//	s := grpc.NewServer()
+//	sodogopb.RegisterSolverServer(s, grpcserver.New(api.Limits{}))
+//	_ = s.Serve(lis)
+//
+// Load errors are results with the invalid status. Calls over the limits are
+// DeadlineExceeded or ResourceExhausted errors, generate accepts boxes up to
+// 4 by default. Other request errors are InvalidArgument errors.
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/rfiestas/sodogo"
+	"github.com/rfiestas/sodogo/proto/sodogopb"
+	"github.com/rfiestas/sodogo/server/api"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// statuses result statuses by server status
+var statuses = map[string]sodogopb.Status{
+	api.StatusSolved:     sodogopb.Status_STATUS_SOLVED,
+	api.StatusUnsolvable: sodogopb.Status_STATUS_UNSOLVABLE,
+	api.StatusValid:      sodogopb.Status_STATUS_VALID,
+	api.StatusInvalid:    sodogopb.Status_STATUS_INVALID,
+	api.StatusGenerated:  sodogopb.Status_STATUS_GENERATED,
+}
+
+// techniques steps techniques by trace technique
+var techniques = map[string]sodogopb.Technique{
+	sodogo.TechniqueNakedSingle:  sodogopb.Technique_TECHNIQUE_NAKED_SINGLE,
+	sodogo.TechniqueHiddenSingle: sodogopb.Technique_TECHNIQUE_HIDDEN_SINGLE,
+}
+
+// solver the Solver service
+type solver struct {
+	sodogopb.UnimplementedSolverServer
+	handler *api.Handler
+}
+
+// New returns the Solver service, running the calls inside the limits like
+// the HTTP/JSON API
+func New(limits api.Limits) sodogopb.SolverServer {
+	return solver{handler: api.New(limits)}
+}
+
+// Solve solves the board, the result has the filled cells in order
+func (s solver) Solve(ctx context.Context, req *sodogopb.SolveRequest) (*sodogopb.SolveResult, error) {
+	r := request(req)
+	trace := []*sodogopb.Step{}
+	res, err := s.handler.SolveTrace(ctx, r, func(step sodogo.TraceStep) {
+		trace = append(trace, newStep(step))
+	})
+	if err != nil {
+		return errorResult(ctx, err)
+	}
+	result := newResult(r, res)
+	result.Trace = trace
+	return result, nil
+}
+
+// SolveSteps solves the board, sending every filled cell as it happens and
+// the result at the end
+func (s solver) SolveSteps(req *sodogopb.SolveRequest, stream grpc.ServerStreamingServer[sodogopb.SolveEvent]) error {
+	r := request(req)
+	// no step is sent once SolveTrace returned, after a timeout the solver
+	// goes on without the stream
+	var mu sync.Mutex
+	var sendErr error
+	returned := false
+	res, err := s.handler.SolveTrace(stream.Context(), r, func(step sodogo.TraceStep) {
+		mu.Lock()
+		defer mu.Unlock()
+		if sendErr == nil && !returned {
+			sendErr = stream.Send(&sodogopb.SolveEvent{Event: &sodogopb.SolveEvent_Step{Step: newStep(step)}})
+		}
+	})
+	mu.Lock()
+	returned = true
+	mu.Unlock()
+	if sendErr != nil {
+		return sendErr
+	}
+	if err != nil {
+		result, err := errorResult(stream.Context(), err)
+		if err != nil {
+			return err
+		}
+		return stream.Send(&sodogopb.SolveEvent{Event: &sodogopb.SolveEvent_Result{Result: result}})
+	}
+	return stream.Send(&sodogopb.SolveEvent{Event: &sodogopb.SolveEvent_Result{Result: newResult(r, res)}})
+}
+
+// Validate checks the board is well formed and without conflicts
+func (s solver) Validate(ctx context.Context, req *sodogopb.SolveRequest) (*sodogopb.SolveResult, error) {
+	return s.call(ctx, "validate", request(req))
+}
+
+// Grade grades the board: easy, medium, hard or unsolvable
+func (s solver) Grade(ctx context.Context, req *sodogopb.SolveRequest) (*sodogopb.SolveResult, error) {
+	return s.call(ctx, "grade", request(req))
+}
+
+// Hint returns the next cell the solver fills
+func (s solver) Hint(ctx context.Context, req *sodogopb.SolveRequest) (*sodogopb.SolveResult, error) {
+	return s.call(ctx, "hint", request(req))
+}
+
+// Generate generates a puzzle the solver can finish, stops when the call is
+// cancelled or times out
+func (s solver) Generate(ctx context.Context, req *sodogopb.GenerateRequest) (*sodogopb.SolveResult, error) {
+	return s.call(ctx, "generate", api.Request{Size: int(req.GetSize()), Options: api.Options{Seed: req.GetSeed()}})
+}
+
+// request returns the api request of a solve request
+func request(req *sodogopb.SolveRequest) api.Request {
+	return api.Request{
+		Size:  int(req.GetBoard().GetSize()),
+		Board: req.GetBoard().GetValues(),
+		Options: api.Options{
+			Hyper:    req.GetOptions().GetHyper(),
+			Disjoint: req.GetOptions().GetDisjoint(),
+			Alphabet: req.GetBoard().GetAlphabet(),
+		},
+	}
+}
+
+// call runs an api endpoint and converts its response
+func (s solver) call(ctx context.Context, name string, req api.Request) (*sodogopb.SolveResult, error) {
+	res, err := s.handler.Call(ctx, name, req)
+	if err != nil {
+		return errorResult(ctx, err)
+	}
+	return newResult(req, res), nil
+}
+
+// errorResult returns the invalid result of a load error, or the gRPC error
+// of the other errors
+func errorResult(ctx context.Context, err error) (*sodogopb.SolveResult, error) {
+	switch {
+	case api.IsInvalid(err):
+		return &sodogopb.SolveResult{Status: sodogopb.Status_STATUS_INVALID, Error: err.Error()}, nil
+	case errors.Is(ctx.Err(), context.Canceled):
+		return nil, status.FromContextError(ctx.Err()).Err()
+	case errors.Is(err, api.ErrTimeout), errors.Is(err, context.DeadlineExceeded):
+		return nil, status.Error(codes.DeadlineExceeded, api.ErrTimeout.Error())
+	case errors.Is(err, api.ErrBusy):
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	}
+	return nil, status.Error(codes.InvalidArgument, err.Error())
+}
+
+// newResult returns the result of an api response
+func newResult(req api.Request, res api.Response) *sodogopb.SolveResult {
+	result := &sodogopb.SolveResult{
+		Status: statuses[res.Status],
+		Steps:  int32(res.Steps),
+		Grade:  res.Grade,
+		Error:  res.Error,
+	}
+	if elapsed, err := time.ParseDuration(res.Elapsed); err == nil {
+		result.ElapsedNanos = elapsed.Nanoseconds()
+	}
+	if res.Board != "" {
+		result.Board = newBoard(req, res.Board)
+	}
+	if res.Hint != nil {
+		result.Hint = &sodogopb.Step{Row: int32(res.Hint.Row), Col: int32(res.Hint.Col), Value: int32(res.Hint.Value)}
+	}
+	return result
+}
+
+// newBoard returns a result board with the request size and alphabet
+func newBoard(req api.Request, values string) *sodogopb.Board {
+	size := req.Size
+	if size == 0 {
+		size = 3
+	}
+	return &sodogopb.Board{Size: int32(size), Values: values, Alphabet: req.Options.Alphabet}
+}
+
+// newStep returns the step of a trace step
+func newStep(step sodogo.TraceStep) *sodogopb.Step {
+	return &sodogopb.Step{
+		Pass:      int32(step.Pass),
+		Row:       int32(step.Position.Row),
+		Col:       int32(step.Position.Col),
+		Value:     int32(step.Value),
+		Technique: techniques[step.Technique],
+		Unit:      step.Unit,
+	}
+}
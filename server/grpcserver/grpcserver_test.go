@@ -0,0 +1,127 @@
+package grpcserver
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/rfiestas/sodogo/proto/sodogopb"
+	"github.com/rfiestas/sodogo/server/api"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const (
+	testPuzzle   = "004300209005009001070060043006002087190007400050083000600000105003508690042910300"
+	testSolution = "864371259325849761971265843436192587198657432257483916689734125713528694542916378"
+)
+
+// testClient returns a client of a Solver service served on a bufconn
+// listener, stopped when the test ends
+func testClient(t *testing.T, limits api.Limits) sodogopb.SolverClient {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	sodogopb.RegisterSolverServer(s, New(limits))
+	go func() { _ = s.Serve(lis) }()
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("grpc.NewClient() error = %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	return sodogopb.NewSolverClient(conn)
+}
+
+func TestSolver_SolveSteps(t *testing.T) {
+	client := testClient(t, api.Limits{})
+	stream, err := client.SolveSteps(context.Background(), &sodogopb.SolveRequest{Board: &sodogopb.Board{Values: testPuzzle}})
+	if err != nil {
+		t.Fatalf("SolveSteps() error = %v", err)
+	}
+
+	board := []byte(testPuzzle)
+	var result *sodogopb.SolveResult
+	for {
+		event, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("SolveSteps() error = %v", err)
+		}
+		if result != nil {
+			t.Fatalf("SolveSteps() event %v after the result", event)
+		}
+		if step := event.GetStep(); step != nil {
+			pos := step.Row*9 + step.Col
+			if board[pos] != '0' || step.Technique == sodogopb.Technique_TECHNIQUE_UNSPECIFIED {
+				t.Errorf("SolveSteps() step %v fills r%dc%d twice or without technique", step, step.Row+1, step.Col+1)
+			}
+			board[pos] = byte('0' + step.Value)
+		}
+		result = event.GetResult()
+	}
+
+	if result == nil || result.Status != sodogopb.Status_STATUS_SOLVED || result.Board.GetValues() != testSolution {
+		t.Fatalf("SolveSteps() result = %v, want solved %v", result, testSolution)
+	}
+	if string(board) != result.Board.GetValues() {
+		t.Errorf("SolveSteps() steps board = %s, want %s", board, result.Board.GetValues())
+	}
+}
+
+func TestSolver(t *testing.T) {
+	client := testClient(t, api.Limits{})
+	ctx := context.Background()
+	puzzle := &sodogopb.SolveRequest{Board: &sodogopb.Board{Values: testPuzzle}}
+
+	res, err := client.Solve(ctx, puzzle)
+	if err != nil || res.Status != sodogopb.Status_STATUS_SOLVED || res.Board.GetValues() != testSolution || len(res.Trace) != 46 {
+		t.Errorf("Solve() = %v %v, want solved with 46 steps", res, err)
+	}
+	res, err = client.Validate(ctx, &sodogopb.SolveRequest{Board: &sodogopb.Board{Size: 2, Values: "1234341221434321"}, Options: &sodogopb.SolveOptions{Hyper: true}})
+	if err != nil || res.Status != sodogopb.Status_STATUS_INVALID || res.Error == "" {
+		t.Errorf("Validate() hyper = %v %v, want invalid", res, err)
+	}
+	res, err = client.Grade(ctx, puzzle)
+	if err != nil || res.Grade != "easy" {
+		t.Errorf("Grade() = %v %v, want easy", res, err)
+	}
+	res, err = client.Hint(ctx, puzzle)
+	if err != nil || res.Hint.GetRow() != 0 || res.Hint.GetCol() != 0 || res.Hint.GetValue() != 8 {
+		t.Errorf("Hint() = %v %v, want r1c1 8", res, err)
+	}
+	res, err = client.Generate(ctx, &sodogopb.GenerateRequest{Size: 2, Seed: 3})
+	if err != nil || res.Status != sodogopb.Status_STATUS_GENERATED || len(res.Board.GetValues()) != 16 {
+		t.Errorf("Generate() = %v %v, want a generated 2x2 board", res, err)
+	}
+
+	res, err = client.Solve(ctx, &sodogopb.SolveRequest{Board: &sodogopb.Board{Values: "123"}})
+	if err != nil || res.Status != sodogopb.Status_STATUS_INVALID || res.Error != "A valid board text contains 81 cells, not 3" {
+		t.Errorf("Solve() invalid board = %v %v, want invalid", res, err)
+	}
+	if _, err = client.Grade(ctx, &sodogopb.SolveRequest{Board: &sodogopb.Board{Size: 9}}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Grade() invalid size error = %v, want InvalidArgument", err)
+	}
+}
+
+func TestSolver_limits(t *testing.T) {
+	ctx := context.Background()
+	_, err := testClient(t, api.Limits{}).Generate(ctx, &sodogopb.GenerateRequest{Size: 5})
+	if status.Code(err) != codes.InvalidArgument || status.Convert(err).Message() != "Invalid size 5, valid generate sizes are 2 to 4" {
+		t.Errorf("Generate() default limit error = %v, want InvalidArgument", err)
+	}
+	_, err = testClient(t, api.Limits{Timeout: time.Millisecond}).Generate(ctx, &sodogopb.GenerateRequest{Size: 4})
+	if status.Code(err) != codes.DeadlineExceeded {
+		t.Errorf("Generate() timeout error = %v, want DeadlineExceeded", err)
+	}
+}
@@ -0,0 +1,130 @@
+// Package server serves the sodogo solver as an HTTP/JSON API.
+//
+// Every endpoint takes a POST with a Request body and answers a Response:
+//
+//	/solve     solves the board
+//	/validate  checks the board is well formed and without conflicts
+//	/grade     grades the board: easy, medium, hard or unsolvable
+//	/hint      returns the next cell the solver fills
+//	/generate  generates a puzzle the solver can finish
+//
+// The requests are run by the api package.
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/rfiestas/sodogo/server/api"
+)
+
+// Response statuses
+const (
+	StatusSolved     = api.StatusSolved
+	StatusUnsolvable = api.StatusUnsolvable
+	StatusValid      = api.StatusValid
+	StatusInvalid    = api.StatusInvalid
+	StatusGenerated  = api.StatusGenerated
+)
+
+// Config server limits, zero values use the defaults
+type Config struct {
+	Timeout       time.Duration // request timeout, 10s by default
+	MaxBodyBytes  int64         // request body limit, 64KiB by default
+	MaxConcurrent int           // requests solving at the same time, the number of CPUs by default
+	MaxSize       int           // greatest box size, 5 (25x25 boards) by default
+	MaxGenerate   int           // greatest /generate box size, 4 (16x16 boards) by default
+}
+
+// Request a request body
+type Request = api.Request
+
+// Options board variants
+type Options = api.Options
+
+// Response a response body
+type Response = api.Response
+
+// Hint a cell and its value, rows and columns from 0
+type Hint = api.Hint
+
+// server the API handlers and their limits
+type server struct {
+	config  Config
+	handler *api.Handler
+}
+
+// New returns the API handler
+func New(config Config) http.Handler {
+	config = config.withDefaults()
+	s := &server{config: config, handler: api.New(config.limits())}
+	mux := http.NewServeMux()
+	for _, name := range api.Names() {
+		mux.Handle("/"+name, s.handle(name))
+	}
+	return mux
+}
+
+// handle decodes the request, runs the endpoint and encodes the response
+func (s *server) handle(name string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			writeJSON(w, http.StatusMethodNotAllowed, Response{Error: "Only POST requests are allowed"})
+			return
+		}
+		var req Request
+		decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, s.config.MaxBodyBytes))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&req); err != nil {
+			writeJSON(w, http.StatusBadRequest, Response{Error: fmt.Sprintf("Invalid request: %v", err)})
+			return
+		}
+		res, err := s.handler.Call(r.Context(), name, req)
+		if err != nil {
+			writeJSON(w, errorStatus(err), api.ErrorResponse(err))
+			return
+		}
+		writeJSON(w, http.StatusOK, res)
+	})
+}
+
+// errorStatus returns the HTTP status of an endpoint error
+func errorStatus(err error) int {
+	switch {
+	case api.IsInvalid(err):
+		return http.StatusUnprocessableEntity
+	case errors.Is(err, api.ErrBusy), errors.Is(err, api.ErrTimeout):
+		return http.StatusServiceUnavailable
+	}
+	return http.StatusBadRequest
+}
+
+// writeJSON writes a JSON response
+func writeJSON(w http.ResponseWriter, status int, res Response) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(res)
+}
+
+// withDefaults returns the config with the default values of the unset fields,
+// the api package sets the default limits
+func (c Config) withDefaults() Config {
+	if c.MaxBodyBytes <= 0 {
+		c.MaxBodyBytes = 64 << 10
+	}
+	return c
+}
+
+// limits returns the request limits of the config
+func (c Config) limits() api.Limits {
+	return api.Limits{
+		Timeout:       c.Timeout,
+		MaxConcurrent: c.MaxConcurrent,
+		MaxSize:       c.MaxSize,
+		MaxGenerate:   c.MaxGenerate,
+	}
+}
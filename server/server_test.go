@@ -0,0 +1,219 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+const (
+	testPuzzle   = "004300209005009001070060043006002087190007400050083000600000105003508690042910300"
+	testSolution = "864371259325849761971265843436192587198657432257483916689734125713528694542916378"
+)
+
+// post sends a request to a handler and decodes the response
+func post(t *testing.T, h http.Handler, method string, path string, body string) (int, Response) {
+	t.Helper()
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	var res Response
+	if err := json.NewDecoder(rec.Body).Decode(&res); err != nil {
+		t.Fatalf("invalid response body: %v", err)
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+		t.Errorf("Content-Type = %v, want application/json", ct)
+	}
+	return rec.Code, res
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		body       string
+		wantStatus int
+		want       Response
+	}{
+		{
+			name:       "solve",
+			path:       "/solve",
+			body:       `{"board":"` + testPuzzle + `"}`,
+			wantStatus: http.StatusOK,
+			want:       Response{Status: StatusSolved, Board: testSolution, Steps: 4},
+		},
+		{
+			name:       "solve 2x2",
+			path:       "/solve",
+			body:       `{"size":2,"board":"1.3.3..2.1.34.2."}`,
+			wantStatus: http.StatusOK,
+			want:       Response{Status: StatusSolved, Board: "1234341221434321", Steps: 3},
+		},
+		{
+			name:       "solve unsolvable",
+			path:       "/solve",
+			body:       `{"board":"800000000003600000070090200050007000000045700000100030001000068008500010090000400"}`,
+			wantStatus: http.StatusOK,
+			want:       Response{Status: StatusUnsolvable, Board: "800000000003600000070090200050007000000045700000100030001000068008500010090000400", Steps: 2},
+		},
+		{
+			name:       "solve invalid board",
+			path:       "/solve",
+			body:       `{"board":"123"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+			want:       Response{Status: StatusInvalid, Error: "A valid board text contains 81 cells, not 3"},
+		},
+		{
+			name:       "validate",
+			path:       "/validate",
+			body:       `{"size":2,"board":"1234341221434321"}`,
+			wantStatus: http.StatusOK,
+			want:       Response{Status: StatusValid, Board: "1234341221434321"},
+		},
+		{
+			name:       "validate conflict",
+			path:       "/validate",
+			body:       `{"size":2,"board":"11.............."}`,
+			wantStatus: http.StatusOK,
+			want:       Response{Status: StatusInvalid, Error: "Given conflicts with position 0 '1' at position 1 (row 0, column 1)"},
+		},
+		{
+			name:       "validate hyper",
+			path:       "/validate",
+			body:       `{"size":2,"board":"1234341221434321","options":{"hyper":true}}`,
+			wantStatus: http.StatusOK,
+			want:       Response{Status: StatusInvalid, Error: "Given conflicts with position 6 '1' at position 9 (row 2, column 1)"},
+		},
+		{
+			name:       "validate no candidates",
+			path:       "/validate",
+			body:       `{"size":2,"board":"12.....3.......4"}`,
+			wantStatus: http.StatusOK,
+			want:       Response{Status: StatusInvalid, Board: "1200000300000004", Error: "Cell r1c4 has no candidates"},
+		},
+		{
+			name:       "grade",
+			path:       "/grade",
+			body:       `{"board":"` + testPuzzle + `"}`,
+			wantStatus: http.StatusOK,
+			want:       Response{Grade: "easy"},
+		},
+		{
+			name:       "hint",
+			path:       "/hint",
+			body:       `{"board":"` + testPuzzle + `"}`,
+			wantStatus: http.StatusOK,
+			want:       Response{Hint: &Hint{Row: 0, Col: 0, Value: 8}},
+		},
+		{
+			name:       "hint solved",
+			path:       "/hint",
+			body:       `{"board":"` + testSolution + `"}`,
+			wantStatus: http.StatusOK,
+			want:       Response{Status: StatusSolved},
+		},
+		{
+			name:       "alphabet",
+			path:       "/solve",
+			body:       `{"size":2,"board":"A.C.C..B.A.CD.B.","options":{"alphabet":"ABCD"}}`,
+			wantStatus: http.StatusOK,
+			want:       Response{Status: StatusSolved, Board: "ABCDCDABBADCDCBA", Steps: 3},
+		},
+		{
+			name:       "invalid alphabet",
+			path:       "/solve",
+			body:       `{"size":2,"board":"A.C.C..B.A.CD.B.","options":{"alphabet":"AB"}}`,
+			wantStatus: http.StatusBadRequest,
+			want:       Response{Error: "A valid alphabet contains 4 symbols, not 2"},
+		},
+		{
+			name:       "generate hyper",
+			path:       "/generate",
+			body:       `{"size":2,"options":{"hyper":true}}`,
+			wantStatus: http.StatusBadRequest,
+			want:       Response{Error: "Boards with extra groups can not be generated"},
+		},
+		{
+			name:       "invalid size",
+			path:       "/solve",
+			body:       `{"size":9,"board":""}`,
+			wantStatus: http.StatusBadRequest,
+			want:       Response{Error: "Invalid size 9, valid sizes are 2 to 5"},
+		},
+		{
+			name:       "unknown field",
+			path:       "/solve",
+			body:       `{"boards":""}`,
+			wantStatus: http.StatusBadRequest,
+			want:       Response{Error: `Invalid request: json: unknown field "boards"`},
+		},
+		{
+			name:       "body too large",
+			path:       "/solve",
+			body:       `{"board":"` + strings.Repeat("0", 64<<10) + `"}`,
+			wantStatus: http.StatusBadRequest,
+			want:       Response{Error: "Invalid request: http: request body too large"},
+		},
+		{
+			name:       "method",
+			method:     http.MethodGet,
+			path:       "/solve",
+			wantStatus: http.StatusMethodNotAllowed,
+			want:       Response{Error: "Only POST requests are allowed"},
+		},
+	}
+	h := New(Config{})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			method := tt.method
+			if method == "" {
+				method = http.MethodPost
+			}
+			status, res := post(t, h, method, tt.path, tt.body)
+			res.Elapsed = ""
+			if status != tt.wantStatus {
+				t.Errorf("status = %v, want %v", status, tt.wantStatus)
+			}
+			if !reflect.DeepEqual(res, tt.want) {
+				t.Errorf("response = %+v, want %+v", res, tt.want)
+			}
+		})
+	}
+}
+
+func TestNew_generate(t *testing.T) {
+	h := New(Config{})
+	_, first := post(t, h, http.MethodPost, "/generate", `{"size":2,"options":{"seed":3}}`)
+	_, second := post(t, h, http.MethodPost, "/generate", `{"size":2,"options":{"seed":3}}`)
+	if first.Status != StatusGenerated || first.Grade == "" || first.Board != second.Board {
+		t.Errorf("response = %+v, want %+v", second, first)
+	}
+	status, res := post(t, h, http.MethodPost, "/solve", `{"size":2,"board":"`+first.Board+`"}`)
+	if status != http.StatusOK || res.Status != StatusSolved {
+		t.Errorf("solve generated = %v %+v", status, res)
+	}
+}
+
+func TestServer_generateTimeout(t *testing.T) {
+	h := New(Config{Timeout: 50 * time.Millisecond, MaxConcurrent: 1, MaxGenerate: 5})
+
+	// the generator stops with the request, its slot is free for the next one
+	status, res := post(t, h, http.MethodPost, "/generate", `{"size":5}`)
+	if status != http.StatusServiceUnavailable || res.Error != "Request timeout" {
+		t.Errorf("generate = %v %+v, want timeout", status, res)
+	}
+	status, res = post(t, h, http.MethodPost, "/solve", `{"board":"`+testPuzzle+`"}`)
+	if status != http.StatusOK || res.Status != StatusSolved {
+		t.Errorf("solve after the timeout = %v %+v, want solved", status, res)
+	}
+
+	status, res = post(t, New(Config{}), http.MethodPost, "/generate", `{"size":5}`)
+	if status != http.StatusBadRequest || res.Error != "Invalid size 5, valid generate sizes are 2 to 4" {
+		t.Errorf("generate default limit = %v %+v, want bad request", status, res)
+	}
+}
@@ -0,0 +1,77 @@
+package sodogo
+
+import (
+	"fmt"
+)
+
+// LoadError an invalid caracter or given on a board definition
+type LoadError struct {
+	Pos    int    // position on the board string
+	Row    int    // row of the position
+	Col    int    // column of the position
+	Symbol byte   // caracter found
+	Reason string // why the caracter is rejected
+}
+
+func (e *LoadError) Error() string {
+	return fmt.Sprintf("%s %q at position %d (row %d, column %d)", e.Reason, e.Symbol, e.Pos, e.Row, e.Col)
+}
+
+// LoadFromStringStrict converts a string to a board rejecting unknown
+// caracters, out of range values, symbols not in a custom alphabet and givens
+// already in conflict. '0' and '.' are empty cells. The board is not modified when an error is returned.
+func (b *Board) LoadFromStringStrict(board string) error {
+	if len(board) != b.helpers.boardSize {
+		return fmt.Errorf("A valid board definition contains %d caracters, not %d", b.helpers.boardSize, len(board))
+	}
+
+	values := make([]int, len(board))
+	for inc := 0; inc < len(board); inc++ {
+		symbol := board[inc]
+		if symbol == '0' || symbol == '.' {
+			continue
+		}
+		values[inc] = b.helpers.getSymbolValue(symbol)
+		if values[inc] != 0 {
+			continue
+		}
+		reason := "Unknown caracter"
+		if b.helpers.alphabet != b.helpers.generateAlphabet() {
+			reason = fmt.Sprintf("Symbol not in the alphabet %s", b.helpers.alphabet)
+		} else if symbol >= '1' && symbol <= '9' {
+			reason = fmt.Sprintf("Value out of range 1-%d", b.helpers.maxValue)
+		}
+		return b.newLoadError(inc, symbol, reason)
+	}
+
+	test := NewBoard(b.helpers)
+	if err := test.LoadFromString(board); err != nil {
+		return err
+	}
+	if duplicates := test.validate().Duplicates; len(duplicates) > 0 {
+		// the value repeated first in the first unit with repeated values
+		first, second := 0, b.helpers.boardSize
+		for _, d := range duplicates {
+			if d.Unit.Kind != duplicates[0].Unit.Kind || d.Unit.Index != duplicates[0].Unit.Index {
+				break
+			}
+			if pos := b.getPos(d.Positions[1]); pos < second {
+				first, second = b.getPos(d.Positions[0]), pos
+			}
+		}
+		return b.newLoadError(second, board[second], fmt.Sprintf("Given conflicts with position %d", first))
+	}
+
+	return b.LoadFromString(board)
+}
+
+// newLoadError returns a LoadError for a board position
+func (b *Board) newLoadError(pos int, symbol byte, reason string) *LoadError {
+	return &LoadError{
+		Pos:    pos,
+		Row:    pos / b.helpers.maxValue,
+		Col:    pos % b.helpers.maxValue,
+		Symbol: symbol,
+		Reason: reason,
+	}
+}
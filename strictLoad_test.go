@@ -0,0 +1,110 @@
+package sodogo
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestBoard_LoadFromStringStrict(t *testing.T) {
+	tests := []struct {
+		name      string
+		board     string
+		wantErr   bool
+		wantPos   int
+		wantValue string
+	}{
+		{
+			name:      "2x2",
+			board:     "1234341221434321",
+			wantErr:   false,
+			wantValue: "1234341221434321",
+		},
+		{
+			name:      "2x2 empty cells",
+			board:     "1.3404122.434321",
+			wantErr:   false,
+			wantValue: "1034041220434321",
+		},
+		{
+			name:    "2x2 unknown caracter",
+			board:   "12X4341221434321",
+			wantErr: true,
+			wantPos: 2,
+		},
+		{
+			name:    "2x2 value out of range",
+			board:   "1234341221434325",
+			wantErr: true,
+			wantPos: 15,
+		},
+		{
+			name:    "2x2 conflicting givens",
+			board:   "1000000000001000",
+			wantErr: true,
+			wantPos: 12,
+		},
+		{
+			name:    "2x2 wrong size",
+			board:   "1234",
+			wantErr: true,
+			wantPos: -1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewBoard(NewHelperBoard(2))
+			err := b.LoadFromStringStrict(tt.board)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Board.LoadFromStringStrict() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			var loadErr *LoadError
+			if errors.As(err, &loadErr) && loadErr.Pos != tt.wantPos {
+				t.Errorf("Board.LoadFromStringStrict() error position = %v, want %v", loadErr.Pos, tt.wantPos)
+			}
+			if err == nil && b.String() != tt.wantValue {
+				t.Errorf("Board.LoadFromStringStrict() board = %v, want %v", b.String(), tt.wantValue)
+			}
+			if err != nil && b.String() != "0000000000000000" {
+				t.Errorf("Board.LoadFromStringStrict() modified the board = %v", b.String())
+			}
+		})
+	}
+}
+
+func TestLoadError_Error(t *testing.T) {
+	tests := []struct {
+		name     string
+		alphabet string
+		board    string
+		want     string
+	}{
+		{
+			name:  "unknown caracter",
+			board: "12X4341221434321",
+			want:  "Unknown caracter 'X' at position 2 (row 0, column 2)",
+		},
+		{
+			name:  "value out of range",
+			board: "1234341221434325",
+			want:  "Value out of range 1-4 '5' at position 15 (row 3, column 3)",
+		},
+		{
+			name:     "symbol not in the alphabet",
+			alphabet: "ABCD",
+			board:    "AB3DCDABBADCDCBA",
+			want:     "Symbol not in the alphabet ABCD '3' at position 2 (row 0, column 2)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewHelperBoard(2)
+			if tt.alphabet != "" {
+				h, _ = h.WithAlphabet(tt.alphabet)
+			}
+			err := NewBoard(h).LoadFromStringStrict(tt.board)
+			if err == nil || err.Error() != tt.want {
+				t.Errorf("LoadError.Error() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package sodogo
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// verticalSeparators split the cells of a line
+const verticalSeparators = "|│║¦<>"
+
+// ignoredCaracters are formatting caracters around the cells, like the parity shading
+const ignoredCaracters = "()[]"
+
+// emptyCells caracters used for empty cells
+const emptyCells = "0._*-"
+
+// NicePrint frame caracters: top border junctions and rules, left borders and
+// left borders of the cell lines
+const (
+	frameJunctions = "╔╤╦╗+"
+	frameRules     = "═="
+	frameBorders   = "╔║╟╠╚|+"
+	frameCells     = "║|"
+)
+
+// LoadFromText converts a text to a board, skipping formatting caracters.
+// Accepted layouts are a line per row with or without spaces, '|' and '-+-'
+// box separators, or the NicePrint output itself, with its markers and
+// outside clues.
+func (b *Board) LoadFromText(text string) error {
+	var buffer bytes.Buffer
+	for _, line := range nicePrintLines(strings.Split(text, "\n")) {
+		if isRuleLine(line, b.helpers.maxValue) {
+			continue
+		}
+		for _, cell := range splitCells(line) {
+			if strings.ContainsRune(emptyCells, cell) {
+				cell = '0'
+			}
+			buffer.WriteRune(cell)
+		}
+	}
+
+	if buffer.Len() != b.helpers.boardSize {
+		return fmt.Errorf("A valid board text contains %d cells, not %d", b.helpers.boardSize, buffer.Len())
+	}
+	return b.LoadFromStringStrict(buffer.String())
+}
+
+// nicePrintLines returns the cell lines of a NicePrint table, cropped to the
+// frame without the outside clues, with the markers between cells replaced by
+// separators. Other texts are returned unchanged.
+func nicePrintLines(lines []string) []string {
+	top, start, width := -1, 0, 0
+	for num, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if isFrameTop(trimmed) {
+			top, width = num, len([]rune(trimmed))
+			start = len([]rune(line[:strings.Index(line, trimmed)]))
+			break
+		}
+	}
+	if top == -1 {
+		return lines
+	}
+
+	res := []string{}
+	for _, line := range lines[top:] {
+		runes := []rune(line)
+		if len(runes) <= start || !strings.ContainsRune(frameBorders, runes[start]) {
+			break
+		}
+		runes = runes[start:]
+		if len(runes) > width {
+			runes = runes[:width]
+		}
+		if !strings.ContainsRune(frameCells, runes[0]) {
+			continue
+		}
+		for col := 0; col < len(runes); col += 4 {
+			runes[col] = '|'
+		}
+		res = append(res, string(runes))
+	}
+	return res
+}
+
+// isFrameTop returns if a line is the top border of a NicePrint table, '═' or
+// '=' rules of three caracters between junctions
+func isFrameTop(line string) bool {
+	runes := []rune(line)
+	if len(runes) < 5 || len(runes)%4 != 1 {
+		return false
+	}
+	for col, r := range runes {
+		caracters := frameRules
+		if col%4 == 0 {
+			caracters = frameJunctions
+		}
+		if !strings.ContainsRune(caracters, r) {
+			return false
+		}
+	}
+	return true
+}
+
+// isRuleLine returns if a line is a horizontal border or an empty line. A
+// line of '-', '|' and spaces is a row of empty cells, unless it has more
+// dashes than a row of maxValue cells holds.
+func isRuleLine(line string, maxValue int) bool {
+	if strings.ContainsAny(line, "─═") {
+		return true
+	}
+	if strings.Trim(line, " \t\r") == "" {
+		return true
+	}
+	if strings.Trim(line, "-+=|"+" \t\r") != "" {
+		return false
+	}
+	return strings.ContainsAny(line, "+=") || strings.Count(line, "-") > maxValue
+}
+
+// splitCells returns the cells of a line. A blank segment between two
+// separators is an empty cell, other segments contain a cell per caracter.
+func splitCells(line string) (cells []rune) {
+	var segment []rune
+	opened := false
+	for _, r := range line {
+		if !strings.ContainsRune(verticalSeparators, r) {
+			segment = append(segment, r)
+			continue
+		}
+		cells = append(cells, segmentCells(segment, opened)...)
+		segment, opened = nil, true
+	}
+	return append(cells, segmentCells(segment, false)...)
+}
+
+// segmentCells returns the cells of a segment, blankIsCell when the segment is closed by separators
+func segmentCells(segment []rune, blankIsCell bool) (cells []rune) {
+	text := strings.Map(func(r rune) rune {
+		if strings.ContainsRune(ignoredCaracters, r) {
+			return ' '
+		}
+		return r
+	}, string(segment))
+	fields := strings.Fields(text)
+	if len(fields) == 0 && blankIsCell && len(segment) > 0 {
+		return []rune{'0'}
+	}
+	for _, field := range fields {
+		cells = append(cells, []rune(field)...)
+	}
+	return cells
+}
@@ -0,0 +1,162 @@
+package sodogo
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBoard_LoadFromText(t *testing.T) {
+	tests := []struct {
+		name    string
+		size    int
+		text    string
+		wantErr bool
+		want    string
+	}{
+		{
+			name:    "3x3 lines",
+			size:    3,
+			text:    "004300209\n005009001\n070060043\n006002087\n190007400\n050083000\n600000105\n003508690\n042910300\n",
+			wantErr: false,
+			want:    "004300209005009001070060043006002087190007400050083000600000105003508690042910300",
+		},
+		{
+			name: "3x3 separators",
+			size: 3,
+			text: `
+. . 4 | 3 . . | 2 . 9
+. . 5 | . . 9 | . . 1
+. 7 . | . 6 . | . 4 3
+------+-------+------
+. . 6 | . . 2 | . 8 7
+1 9 . | . . 7 | 4 . .
+. 5 . | . 8 3 | . . .
+------+-------+------
+6 . . | . . . | 1 . 5
+. . 3 | 5 . 8 | 6 9 .
+. 4 2 | 9 1 . | 3 . .
+`,
+			wantErr: false,
+			want:    "004300209005009001070060043006002087190007400050083000600000105003508690042910300",
+		},
+		{
+			name:    "2x2 one line",
+			size:    2,
+			text:    "  1234 3412\t2143 4321  ",
+			wantErr: false,
+			want:    "1234341221434321",
+		},
+		{
+			name:    "2x2 nice print",
+			size:    2,
+			text:    "╔═══╤═══╦═══╤═══╗\n║ 1 │   ║ 3 │ 4 ║\n╟───┼───╫───┼───╢\n║ 3 │ 4 ║ 1 │ 2 ║\n╠═══╪═══╬═══╪═══╣\n║ 2 │ 1 ║   │ 3 ║\n╟───┼───╫───┼───╢\n║ 4 │ 3 ║ 2 │ 1 ║\n╚═══╧═══╩═══╧═══╝\n",
+			wantErr: false,
+			want:    "1034341221034321",
+		},
+		{
+			name:    "2x2 nice print with parity and markers",
+			size:    2,
+			text:    "    ╔═══╤═══╦═══╤═══╗\n    ║(1)<[ ]║ 3 │ 4 ║\n    ╟ ^ ┼───╫───┼───╢\n    ║ 3 │ 4 ║ 1 │ 2 ║\n    ╠═══╪═══╬═══╪═══╣\n    ║ 2 │ 1 ║   │ 3 ║\n    ╟───┼───╫───┼───╢\n    ║ 4 │ 3 ║ 2 > 1 ║\n    ╚═══╧═══╩═══╧═══╝\n",
+			wantErr: false,
+			want:    "1034341221034321",
+		},
+		{
+			name:    "2x2 dash empty rows",
+			size:    2,
+			text:    "- - | - -\n3 4 | 1 2\n---------\n--|--\n4 3 | 2 1\n",
+			wantErr: false,
+			want:    "0000341200004321",
+		},
+		{
+			name:    "2x2 dash rules",
+			size:    2,
+			text:    "1 2 | - 4\n-----\n3 4 | 1 2\n-----+-----\n2 1 | 4 3\n4 - | 2 1\n",
+			wantErr: false,
+			want:    "1204341221434021",
+		},
+		{
+			name:    "2x2 missing cells",
+			size:    2,
+			text:    "1234\n3412\n2143\n",
+			wantErr: true,
+		},
+		{
+			name:    "2x2 unknown caracter",
+			size:    2,
+			text:    "1234\n3412\n2143\n432?\n",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewBoard(NewHelperBoard(tt.size))
+			if err := b.LoadFromText(tt.text); (err != nil) != tt.wantErr {
+				t.Fatalf("Board.LoadFromText() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && b.String() != tt.want {
+				t.Errorf("Board.LoadFromText() = %v, want %v", b.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestBoard_LoadFromTextNicePrint(t *testing.T) {
+	b := test3x3BoardUnsolved()
+	res := NewBoard(NewHelperBoard(3))
+	if err := res.LoadFromText(b.NicePrint()); err != nil || res.String() != b.String() {
+		t.Errorf("Board.LoadFromText() = %v %v, want %v", res.String(), err, b.String())
+	}
+}
+
+func TestBoard_LoadFromTextNicePrintRoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		board func() *Board
+	}{
+		{
+			name: "3x3 markers and clues",
+			board: func() *Board {
+				b := test3x3BoardUnsolved()
+				_ = b.AddMarkers(XV{Position{0, 0}, Position{0, 1}, X}, GreaterThan{Position{0, 0}, Position{1, 0}}, XV{Position{2, 2}, Position{3, 2}, V})
+				_ = b.AddClues(Sandwich{Top, 0, 12}, LittleKiller{Left, 2, DownRight, 31})
+				return b
+			},
+		},
+		{
+			name: "2x2 parity and clues",
+			board: func() *Board {
+				b := test2x2BoardParity()
+				_ = b.AddClues(Sandwich{Top, 0, 5}, Sandwich{Right, 3, 0})
+				return b
+			},
+		},
+		{
+			name: "2x2 markers on every separator",
+			board: func() *Board {
+				b := test2x2BoardSolved()
+				_ = b.AddMarkers(GreaterThan{Position{1, 0}, Position{0, 0}}, GreaterThan{Position{1, 1}, Position{0, 1}}, GreaterThan{Position{0, 2}, Position{1, 2}}, GreaterThan{Position{0, 3}, Position{1, 3}}, XV{Position{0, 1}, Position{0, 2}, V})
+				return b
+			},
+		},
+		{
+			name: "5x5 X and V values beside markers",
+			board: func() *Board {
+				b := NewBoard(NewHelperBoard(5))
+				_ = b.LoadFromString("XV" + strings.Repeat("0", 623))
+				_ = b.AddMarkers(XV{Position{0, 1}, Position{0, 2}, X}, XV{Position{0, 2}, Position{0, 3}, V}, GreaterThan{Position{0, 1}, Position{1, 1}})
+				return b
+			},
+		},
+	}
+	for _, tt := range tests {
+		for _, style := range []PrintStyle{BoxStyle, ASCIIStyle} {
+			t.Run(tt.name, func(t *testing.T) {
+				b := tt.board()
+				res := NewBoard(b.helpers)
+				if err := res.LoadFromText(b.NicePrintWith(PrintOptions{Style: style})); err != nil || res.String() != b.String() {
+					t.Errorf("Board.LoadFromText() = %v %v, want %v", res.String(), err, b.String())
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package sodogo
+
+// Solving techniques
+const (
+	TechniqueNakedSingle  = "naked single"  // the only candidate of a cell
+	TechniqueHiddenSingle = "hidden single" // the only cell of a unit with a candidate
+)
+
+// TraceStep a cell filled by the solver
+type TraceStep struct {
+	Pass      int      // solver pass, from 1
+	Position  Position // filled cell
+	Value     int      // filled value
+	Technique string   // TechniqueNakedSingle or TechniqueHiddenSingle
+	Unit      string   // hidden single unit: box, row, column or group
+}
+
+// SolveTrace solves the board like Solve, calling trace for every filled
+// cell as it happens
+func (b *Board) SolveTrace(trace func(TraceStep)) bool {
+	b.tracer = trace
+	defer func() { b.tracer = nil }()
+	return b.Solve()
+}
+
+// trace reports a filled cell to the tracer
+func (b *Board) trace(pos int, technique string, unit string) {
+	if b.tracer == nil {
+		return
+	}
+	b.tracer(TraceStep{
+		Pass:      b.Steps,
+		Position:  Position{pos / b.helpers.maxValue, pos % b.helpers.maxValue},
+		Value:     b.getValue(pos),
+		Technique: technique,
+		Unit:      unit,
+	})
+}
+
+// unitName returns the name of a hidden single unit
+func unitName(np neighborsPotential) string {
+	switch np.(type) {
+	case flatNeighborsPotential:
+		return UnitBox
+	case streetYNeighborsPotential:
+		return UnitRow
+	case streetXNeighborsPotential:
+		return UnitColumn
+	}
+	return UnitGroup
+}
@@ -0,0 +1,100 @@
+package sodogo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBoard_SolveTrace(t *testing.T) {
+	tests := []struct {
+		name      string
+		b         *Board
+		want      bool
+		wantFirst TraceStep
+	}{
+		{
+			name:      "3x3",
+			b:         test3x3BoardUnsolved(),
+			want:      true,
+			wantFirst: TraceStep{Pass: 1, Position: Position{0, 0}, Value: 8, Technique: TechniqueNakedSingle},
+		},
+		{
+			name: "3x3 impossible",
+			b:    test3x3BoardImpossible(),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			empty := []int{}
+			for pos := 0; pos < tt.b.helpers.boardSize; pos++ {
+				if tt.b.getValue(pos) == 0 {
+					empty = append(empty, pos)
+				}
+			}
+			steps := []TraceStep{}
+			res := tt.b.SolveTrace(func(step TraceStep) { steps = append(steps, step) })
+			if res != tt.want {
+				t.Errorf("Board.SolveTrace() res = %v, want %v", res, tt.want)
+			}
+			if !tt.want {
+				if len(steps) >= len(empty) {
+					t.Errorf("Board.SolveTrace() steps = %v, empty cells %v", len(steps), len(empty))
+				}
+				return
+			}
+			if len(steps) != len(empty) {
+				t.Fatalf("Board.SolveTrace() steps = %v, want %v", len(steps), len(empty))
+			}
+			if !reflect.DeepEqual(steps[0], tt.wantFirst) {
+				t.Errorf("Board.SolveTrace() first step = %+v, want %+v", steps[0], tt.wantFirst)
+			}
+			last := steps[len(steps)-1]
+			if last.Pass != tt.b.Steps-1 && last.Pass != tt.b.Steps {
+				t.Errorf("Board.SolveTrace() last pass = %v, steps %v", last.Pass, tt.b.Steps)
+			}
+			filled := map[int]bool{}
+			for _, step := range steps {
+				pos := tt.b.getPos(step.Position)
+				if filled[pos] || tt.b.getValue(pos) != step.Value {
+					t.Errorf("Board.SolveTrace() step %+v, board value %v", step, tt.b.getValue(pos))
+				}
+				filled[pos] = true
+				if (step.Technique == TechniqueHiddenSingle) != (step.Unit != "") {
+					t.Errorf("Board.SolveTrace() step %+v, unit only for hidden singles", step)
+				}
+			}
+			if tt.b.tracer != nil {
+				t.Errorf("Board.SolveTrace() kept the tracer")
+			}
+		})
+	}
+}
+
+func TestBoard_SolveTrace_techniques(t *testing.T) {
+	b := NewBoard(NewHelperBoard(2))
+	_ = b.LoadFromString("1230000000000000")
+	steps := []TraceStep{}
+	b.SolveTrace(func(step TraceStep) { steps = append(steps, step) })
+	want := TraceStep{Pass: 1, Position: Position{0, 3}, Value: 4, Technique: TechniqueNakedSingle}
+	if len(steps) == 0 || steps[0] != want {
+		t.Errorf("Board.SolveTrace() steps = %+v, want first %+v", steps, want)
+	}
+}
+
+func Test_unitName(t *testing.T) {
+	tests := []struct {
+		np   neighborsPotential
+		want string
+	}{
+		{flatNeighborsPotential{}, "box"},
+		{streetYNeighborsPotential{}, "row"},
+		{streetXNeighborsPotential{}, "column"},
+		{extraNeighborsPotential{}, "group"},
+	}
+	for _, tt := range tests {
+		if res := unitName(tt.np); res != tt.want {
+			t.Errorf("unitName() res = %v, want %v", res, tt.want)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package sodogo
+
+import (
+	"sort"
+)
+
+// Unit kinds
+const (
+	UnitBox    = "box"
+	UnitRow    = "row"
+	UnitColumn = "column"
+	UnitGroup  = "group" // hyper window or disjoint group
+)
+
+// Unit cells that can not repeat a value
+type Unit struct {
+	Kind  string     // UnitBox, UnitRow, UnitColumn or UnitGroup
+	Index int        // unit number by kind, from 0
+	Cells []Position // unit cells, in board order
+}
+
+// newUnit returns a unit by its getUnits index and cells
+func (h HelperBoard) newUnit(num int, unit []int) Unit {
+	kinds := []string{UnitBox, UnitRow, UnitColumn}
+	kind, index := UnitGroup, num-len(kinds)*h.maxValue
+	if num < len(kinds)*h.maxValue {
+		kind, index = kinds[num/h.maxValue], num%h.maxValue
+	}
+	positions := append([]int{}, unit...)
+	sort.Ints(positions)
+	cells := make([]Position, len(positions))
+	for inc, pos := range positions {
+		cells[inc] = h.position(pos)
+	}
+	return Unit{Kind: kind, Index: index, Cells: cells}
+}
+
+// position returns the row and column of a cell
+func (h HelperBoard) position(pos int) Position {
+	return Position{pos / h.maxValue, pos % h.maxValue}
+}
@@ -0,0 +1,136 @@
+package sodogo
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ValidationError every rule a board breaks, returned by Validate
+type ValidationError struct {
+	Duplicates   []Duplicate // values repeated in a box, row, column or group
+	Parity       []Position  // values not following their cell parity
+	Rules        []Position  // values breaking a marker or outside clue
+	NoCandidates []Position  // empty cells without candidates
+	problems     []string    // problem descriptions, in order
+}
+
+// Duplicate a value repeated in a unit
+type Duplicate struct {
+	Unit      Unit       // box, row, column or group
+	Value     int        // repeated value
+	Positions []Position // cells with the value, in board order
+}
+
+func (e *ValidationError) Error() string {
+	return strings.Join(e.problems, "; ")
+}
+
+// Validate returns a *ValidationError listing every repeated value, value
+// against its parity, broken marker or outside clue and empty cell without
+// candidates, or nil when there is none
+func (b *Board) Validate() error {
+	if e := b.validate(); len(e.problems) > 0 {
+		return e
+	}
+	return nil
+}
+
+// validate returns the Validate report: the printed conflicts, strict
+// loading, Game.Conflicts and Set use it. IsValid checks the same rules
+// without a report, stopping at the first broken one, and Game.IsWrong checks
+// a single cell.
+func (b *Board) validate() *ValidationError {
+	e := &ValidationError{}
+	for num, unit := range b.helpers.getUnits() {
+		found := map[int][]int{}
+		values := []int{}
+		for _, pos := range unit {
+			value := b.getValue(pos)
+			if value == 0 {
+				continue
+			}
+			if len(found[value]) == 0 {
+				values = append(values, value)
+			}
+			found[value] = append(found[value], pos)
+		}
+		for _, value := range values {
+			if len(found[value]) < 2 {
+				continue
+			}
+			d := Duplicate{Unit: b.helpers.newUnit(num, unit), Value: value, Positions: b.positions(found[value])}
+			e.Duplicates = append(e.Duplicates, d)
+			e.problems = append(e.problems, fmt.Sprintf("%s%s %d repeats %c at %s", strings.ToUpper(d.Unit.Kind[:1]),
+				d.Unit.Kind[1:], d.Unit.Index+1, b.helpers.getSymbol(value), joinPositions(d.Positions)))
+		}
+	}
+
+	rules := map[int]bool{}
+	for _, c := range b.constraints {
+		for _, pos := range c.conflicts(b) {
+			rules[pos] = true
+		}
+	}
+	for pos := 0; pos < b.helpers.boardSize; pos++ {
+		p, value := b.helpers.position(pos), b.getValue(pos)
+		switch {
+		case value != 0 && !b.data[pos].parity.allows(value):
+			e.Parity = append(e.Parity, p)
+			e.problems = append(e.problems, fmt.Sprintf("Cell %v breaks its parity", p))
+		case value == 0 && len(b.getCandidates(pos)) == 0:
+			e.NoCandidates = append(e.NoCandidates, p)
+			e.problems = append(e.problems, fmt.Sprintf("Cell %v has no candidates", p))
+		}
+		if rules[pos] {
+			e.Rules = append(e.Rules, p)
+		}
+	}
+	switch {
+	case len(e.Rules) == 1:
+		e.problems = append(e.problems, fmt.Sprintf("Cell %v breaks a marker or outside clue", e.Rules[0]))
+	case len(e.Rules) > 1:
+		e.problems = append(e.problems, fmt.Sprintf("Cells %s break a marker or outside clue", joinPositions(e.Rules)))
+	}
+	return e
+}
+
+// conflictCells returns the filled cells of a report repeating a value in a
+// unit, not following their parity or breaking a marker or outside clue
+func (b *Board) conflictCells(e *ValidationError) map[int]bool {
+	conflicts := map[int]bool{}
+	for _, d := range e.Duplicates {
+		for _, p := range d.Positions {
+			conflicts[b.getPos(p)] = true
+		}
+	}
+	for _, p := range e.Parity {
+		conflicts[b.getPos(p)] = true
+	}
+	for _, p := range e.Rules {
+		conflicts[b.getPos(p)] = true
+	}
+	return conflicts
+}
+
+// positions returns the rows and columns of some cells, in board order
+func (b *Board) positions(cells []int) (res []Position) {
+	sorted := append([]int{}, cells...)
+	sort.Ints(sorted)
+	for _, pos := range sorted {
+		res = append(res, b.helpers.position(pos))
+	}
+	return res
+}
+
+// joinPositions returns some cells as "r1c1, r1c2 and r1c3"
+func joinPositions(positions []Position) string {
+	names := []string{}
+	for _, p := range positions {
+		names = append(names, p.String())
+	}
+	if len(names) < 2 {
+		return strings.Join(names, "")
+	}
+	return strings.Join(names[:len(names)-1], ", ") + " and " + names[len(names)-1]
+}
@@ -0,0 +1,115 @@
+package sodogo
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestBoard_Validate(t *testing.T) {
+	duplicate := NewBoard(NewHelperBoard(2))
+	_ = duplicate.LoadFromString("1000000000000000")
+	duplicate.setValue(1, 1)
+	parity := test2x2BoardParity()
+	parity.setValue(2, 4)
+	marker := test2x2BoardSolved()
+	_ = marker.AddMarkers(GreaterThan{Position{0, 0}, Position{0, 1}})
+	noCandidates := NewBoard(NewHelperBoard(2))
+	_ = noCandidates.LoadFromString("1200000300000004")
+	hyper := NewBoard(NewHelperBoard(2).WithHyper())
+	_ = hyper.LoadFromString("1234341221434321")
+
+	tests := []struct {
+		name    string
+		b       *Board
+		want    *ValidationError
+		wantErr string
+	}{
+		{
+			name: "valid",
+			b:    test2x2BoardSolved(),
+		},
+		{
+			name: "valid unsolved",
+			b:    test3x3BoardUnsolved(),
+		},
+		{
+			name: "duplicate",
+			b:    duplicate,
+			want: &ValidationError{Duplicates: []Duplicate{
+				{Unit{UnitBox, 0, []Position{{0, 0}, {0, 1}, {1, 0}, {1, 1}}}, 1, []Position{{0, 0}, {0, 1}}},
+				{Unit{UnitRow, 0, []Position{{0, 0}, {0, 1}, {0, 2}, {0, 3}}}, 1, []Position{{0, 0}, {0, 1}}},
+			}},
+			wantErr: "Box 1 repeats 1 at r1c1 and r1c2; Row 1 repeats 1 at r1c1 and r1c2",
+		},
+		{
+			name: "hyper",
+			b:    hyper,
+			want: &ValidationError{Duplicates: []Duplicate{
+				{Unit{UnitGroup, 0, []Position{{1, 1}, {1, 2}, {2, 1}, {2, 2}}}, 4, []Position{{1, 1}, {2, 2}}},
+				{Unit{UnitGroup, 0, []Position{{1, 1}, {1, 2}, {2, 1}, {2, 2}}}, 1, []Position{{1, 2}, {2, 1}}},
+			}},
+			wantErr: "Group 1 repeats 4 at r2c2 and r3c3; Group 1 repeats 1 at r2c3 and r3c2",
+		},
+		{
+			name:    "parity",
+			b:       parity,
+			want:    &ValidationError{Parity: []Position{{0, 2}}, NoCandidates: []Position{{0, 3}, {2, 2}}},
+			wantErr: "Cell r1c3 breaks its parity; Cell r1c4 has no candidates; Cell r3c3 has no candidates",
+		},
+		{
+			name:    "marker",
+			b:       marker,
+			want:    &ValidationError{Rules: []Position{{0, 0}, {0, 1}}},
+			wantErr: "Cells r1c1 and r1c2 break a marker or outside clue",
+		},
+		{
+			name:    "no candidates",
+			b:       noCandidates,
+			want:    &ValidationError{NoCandidates: []Position{{0, 3}}},
+			wantErr: "Cell r1c4 has no candidates",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.b.Validate()
+			if tt.want == nil {
+				if err != nil {
+					t.Errorf("Board.Validate() err = %v, want nil", err)
+				}
+				return
+			}
+			var res *ValidationError
+			if !errors.As(err, &res) {
+				t.Fatalf("Board.Validate() err = %v, want a *ValidationError", err)
+			}
+			if err.Error() != tt.wantErr {
+				t.Errorf("Board.Validate() err = %v, want %v", err, tt.wantErr)
+			}
+			res.problems = nil
+			if !reflect.DeepEqual(res, tt.want) {
+				t.Errorf("Board.Validate() res = %+v, want %+v", res, tt.want)
+			}
+			if tt.b.IsValid() != (len(res.Duplicates)+len(res.Parity)+len(res.Rules) == 0) {
+				t.Errorf("Board.IsValid() = %v, report %+v", tt.b.IsValid(), res)
+			}
+		})
+	}
+}
+
+func Test_joinPositions(t *testing.T) {
+	tests := []struct {
+		positions []Position
+		want      string
+	}{
+		{nil, ""},
+		{[]Position{{0, 0}}, "r1c1"},
+		{[]Position{{0, 0}, {1, 1}}, "r1c1 and r2c2"},
+		{[]Position{{0, 0}, {1, 1}, {2, 2}}, "r1c1, r2c2 and r3c3"},
+	}
+	for _, tt := range tests {
+		if res := joinPositions(tt.positions); res != tt.want {
+			t.Errorf("joinPositions() res = %v, want %v", res, tt.want)
+		}
+	}
+}